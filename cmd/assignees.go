@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var listAssigneesCmd = &cobra.Command{
+	Use:   "list-assignees",
+	Short: "List distinct task assignees",
+	Long:  `Prints every assignee used on the board with the number of tasks assigned.`,
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runDistinct(board.DistinctAssignees)
+	},
+}
+
+var listClaimantsCmd = &cobra.Command{
+	Use:   "list-claimants",
+	Short: "List distinct task claimants",
+	Long:  `Prints every agent holding a claim on the board with the number of claimed tasks.`,
+	Args:  cobra.NoArgs,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return runDistinct(board.DistinctClaimants)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(listAssigneesCmd)
+	rootCmd.AddCommand(listClaimantsCmd)
+}
+
+func runDistinct(distinct func([]*task.Task) []board.ValueCount) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	values := distinct(tasks)
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, values)
+	case output.FormatCompact:
+		for _, v := range values {
+			fmt.Fprintln(os.Stdout, v.Value)
+		}
+	default:
+		for _, v := range values {
+			fmt.Fprintf(os.Stdout, "%-24s %d\n", v.Value, v.Count)
+		}
+	}
+	return nil
+}
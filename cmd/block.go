@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var blockCmd = &cobra.Command{
+	Use:   "block ID[,ID,...]",
+	Short: "Mark a task as blocked",
+	Long: `Marks tasks as blocked with a reason. Blocking an already blocked task
+replaces its reason. Multiple IDs can be provided as a comma-separated list.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBlock,
+}
+
+var unblockCmd = &cobra.Command{
+	Use:   "unblock ID[,ID,...]",
+	Short: "Clear a task's blocked state",
+	Long: `Clears the blocked state of tasks. Unblocking a task that is not blocked
+is an error. Multiple IDs can be provided as a comma-separated list.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUnblock,
+}
+
+func init() {
+	blockCmd.Flags().String("reason", "", "why the task is blocked (required)")
+	blockCmd.Flags().String("claim", "", "claimant name for claimed tasks")
+	unblockCmd.Flags().String("claim", "", "claimant name for claimed tasks")
+	rootCmd.AddCommand(blockCmd)
+	rootCmd.AddCommand(unblockCmd)
+}
+
+func runBlock(cmd *cobra.Command, args []string) error {
+	reason, _ := cmd.Flags().GetString("reason")
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return clierr.New(clierr.InvalidInput, "block reason is required (use --reason TEXT)")
+	}
+
+	return runBlockChange(cmd, args[0], func(t *task.Task) error {
+		t.Blocked = true
+		t.BlockReason = reason
+		return nil
+	}, func(cfg *config.Config, t *task.Task) {
+		logActivityWithReason(cfg, "block", t.ID, reason, reason)
+	}, "Blocked task #%d: %s")
+}
+
+func runUnblock(cmd *cobra.Command, args []string) error {
+	return runBlockChange(cmd, args[0], func(t *task.Task) error {
+		if !t.Blocked {
+			return clierr.Newf(clierr.NoChanges, "task #%d is not blocked", t.ID)
+		}
+		t.Blocked = false
+		t.BlockReason = ""
+		return nil
+	}, func(cfg *config.Config, t *task.Task) {
+		logActivity(cfg, "unblock", t.ID, t.Title)
+	}, "Unblocked task #%d: %s")
+}
+
+// runBlockChange applies apply to each task in arg, writing and logging it.
+// A single ID prints the updated task; multiple IDs report via runBatch.
+func runBlockChange(cmd *cobra.Command, arg string,
+	apply func(*task.Task) error,
+	logFn func(*config.Config, *task.Task),
+	message string,
+) error {
+	ids, err := parseIDs(arg)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	claimant, _ := cmd.Flags().GetString("claim")
+	setActor(claimant)
+
+	execute := func(id int) (*task.Task, error) {
+		var t *task.Task
+		err := withBoardLock(cfg, func() error {
+			path, err := task.FindByID(cfg.TasksPath(), id)
+			if err != nil {
+				return err
+			}
+			if t, err = task.Read(path); err != nil {
+				return err
+			}
+			if err = checkClaim(t, claimant, cfg.ClaimTimeoutDuration()); err != nil {
+				return err
+			}
+			if err = apply(t); err != nil {
+				return err
+			}
+			t.Updated = time.Now()
+			if err := task.Write(path, t); err != nil {
+				return fmt.Errorf("writing task: %w", err)
+			}
+			logFn(cfg, t)
+			return nil
+		})
+		return t, err
+	}
+
+	if len(ids) > 1 {
+		return runBatch(ids, func(id int) error {
+			_, err := execute(id)
+			return err
+		})
+	}
+
+	t, err := execute(ids[0])
+	if err != nil {
+		return err
+	}
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, t)
+	}
+	output.Messagef(os.Stdout, message, t.ID, t.Title)
+	return nil
+}
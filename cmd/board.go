@@ -7,6 +7,7 @@ import (
 	"os/signal"
 	"slices"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -20,7 +21,11 @@ import (
 	"github.com/twiced-technology-gmbh/agentwatch/internal/watcher"
 )
 
-var flagWatch bool
+var (
+	flagWatch      bool
+	flagPercent    bool
+	flagByAssignee bool
+)
 
 var boardCmd = &cobra.Command{
 	Use:     "board",
@@ -31,7 +36,16 @@ blocked and overdue counts, and priority distribution.
 
 Use --watch to keep the display live-updating. The board re-renders automatically
 whenever task files change on disk (e.g., from another terminal or an AI agent).
-Press Ctrl+C to stop.`,
+Press Ctrl+C to stop.
+
+Archived tasks are hidden; use --include-archived to add an archived column
+or --archived to summarize only archived tasks.
+
+Columns over their WIP limit are marked with "!". Use --strict to exit with
+status 1 when any column is over its limit, e.g. to gate CI.
+
+With --compact, --percent adds each status's share of the total and a bar
+scaled to the largest status.`,
 	RunE: runBoard,
 }
 
@@ -39,6 +53,14 @@ func init() {
 	rootCmd.AddCommand(boardCmd)
 	boardCmd.Flags().BoolVarP(&flagWatch, "watch", "w", false, "live-update the board on file changes")
 	boardCmd.Flags().String("group-by", "", "group board by field ("+strings.Join(board.ValidGroupByFields(), ", ")+")")
+	boardCmd.Flags().BoolVar(&flagPercent, "percent", false, "show percentages and bars in compact output")
+	boardCmd.Flags().BoolVar(&flagByAssignee, "by-assignee", false, "show the overview separately for each assignee")
+	boardCmd.MarkFlagsMutuallyExclusive("by-assignee", "group-by")
+	boardCmd.MarkFlagsMutuallyExclusive("by-assignee", "percent")
+	boardCmd.Flags().Bool("strict", false, "exit 1 if any column exceeds its WIP limit")
+	boardCmd.MarkFlagsMutuallyExclusive("strict", "watch")
+	addArchivedFlags(boardCmd)
+	_ = boardCmd.RegisterFlagCompletionFunc("group-by", completeGroupBy)
 }
 
 func runBoard(cmd *cobra.Command, _ []string) error {
@@ -53,48 +75,54 @@ func runBoard(cmd *cobra.Command, _ []string) error {
 			groupBy, strings.Join(board.ValidGroupByFields(), ", "))
 	}
 
+	if flagPercent && (outputFormat() != output.FormatCompact || groupBy != "") {
+		return clierr.New(clierr.InvalidInput, "--percent requires --compact output without --group-by")
+	}
+
+	mode := archivedMode(cmd)
+
 	// Render once.
-	if err := renderBoard(cfg, groupBy); err != nil {
+	if err := renderBoard(cfg, groupBy, mode); err != nil {
 		return err
 	}
 
+	if strict, _ := cmd.Flags().GetBool("strict"); strict {
+		return checkBoardWIP(cfg, mode)
+	}
+
 	if !flagWatch {
 		return nil
 	}
 
-	return watchBoard(cfg, groupBy)
+	return watchBoard(cfg, groupBy, mode)
 }
 
-func renderBoard(cfg *config.Config, groupBy string) error {
-	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+func renderBoard(cfg *config.Config, groupBy string, mode board.ArchivedMode) error {
+	activeTasks, err := boardTasks(cfg, mode, true)
 	if err != nil {
 		return err
 	}
-	printWarnings(warnings)
-	if tasks == nil {
-		tasks = []*task.Task{}
-	}
-
-	// Exclude archived tasks from board display.
-	var activeTasks []*task.Task
-	for _, t := range tasks {
-		if !cfg.IsArchivedStatus(t.Status) {
-			activeTasks = append(activeTasks, t)
-		}
-	}
 
 	if groupBy != "" {
 		return renderGroupedBoard(cfg, activeTasks, groupBy)
 	}
 
-	summary := board.Summary(cfg, activeTasks, time.Now())
+	opts := board.SummaryOptions{Now: time.Now(), FlowMetrics: true}
+	if flagByAssignee {
+		return renderAssigneeBoard(cfg, activeTasks, opts)
+	}
+	summary := board.Summary(cfg, activeTasks, opts)
 
 	format := outputFormat()
 	if format == output.FormatJSON {
 		return output.JSON(os.Stdout, summary)
 	}
 	if format == output.FormatCompact {
-		output.OverviewCompact(os.Stdout, summary)
+		if flagPercent {
+			output.OverviewCompactPercent(os.Stdout, summary)
+		} else {
+			output.OverviewCompact(os.Stdout, summary)
+		}
 		return nil
 	}
 
@@ -102,8 +130,54 @@ func renderBoard(cfg *config.Config, groupBy string) error {
 	return nil
 }
 
+// renderAssigneeBoard renders the overview once per assignee.
+func renderAssigneeBoard(cfg *config.Config, tasks []*task.Task, opts board.SummaryOptions) error {
+	overviews := board.SummaryByAssignee(cfg, tasks, opts)
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, overviews)
+	case output.FormatCompact:
+		output.AssigneeOverviewCompact(os.Stdout, overviews)
+	default:
+		output.AssigneeOverviewTable(os.Stdout, overviews)
+	}
+	return nil
+}
+
+// boardTasks reads the tasks shown on the board for the archived mode.
+func boardTasks(cfg *config.Config, mode board.ArchivedMode, warn bool) ([]*task.Task, error) {
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return nil, err
+	}
+	if warn {
+		printWarnings(warnings)
+	}
+
+	var filter board.FilterOptions
+	board.ApplyArchived(&filter, mode)
+	return board.Filter(tasks, filter), nil
+}
+
+// checkBoardWIP reports columns over their WIP limit to stderr and returns a
+// SilentError if there are any.
+func checkBoardWIP(cfg *config.Config, mode board.ArchivedMode) error {
+	tasks, err := boardTasks(cfg, mode, false)
+	if err != nil {
+		return err
+	}
+	over := board.Summary(cfg, tasks, board.SummaryOptions{Now: time.Now()}).OverWIP()
+	if len(over) == 0 {
+		return nil
+	}
+	for _, ss := range over {
+		fmt.Fprintf(os.Stderr, "WIP limit exceeded: %s has %d tasks (limit %d)\n", ss.Status, ss.Count, ss.WIPLimit)
+	}
+	return &clierr.SilentError{Code: 1}
+}
+
 func renderGroupedBoard(cfg *config.Config, tasks []*task.Task, groupBy string) error {
-	grouped := board.GroupBy(tasks, groupBy, cfg)
+	grouped := board.GroupBy(tasks, groupLookup(cfg, groupBy), groupBy, cfg)
 
 	if outputFormat() == output.FormatJSON {
 		return output.JSON(os.Stdout, grouped)
@@ -113,14 +187,19 @@ func renderGroupedBoard(cfg *config.Config, tasks []*task.Task, groupBy string)
 	return nil
 }
 
-func watchBoard(cfg *config.Config, groupBy string) error {
+func watchBoard(cfg *config.Config, groupBy string, mode board.ArchivedMode) error {
 	// Watch both the tasks directory and the config file's directory.
 	watchPaths := []string{cfg.TasksPath(), cfg.Dir()}
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
-	w, err := watcher.New(watchPaths, func() {
+	// The watcher's debounce timer and the SIGHUP handler both render;
+	// serialize them so frames don't interleave.
+	var mu sync.Mutex
+	render := func() {
+		mu.Lock()
+		defer mu.Unlock()
 		clearScreen()
 		// Re-load config in case statuses/WIP limits changed.
 		freshCfg, loadErr := config.Load(cfg.Dir())
@@ -128,16 +207,34 @@ func watchBoard(cfg *config.Config, groupBy string) error {
 			fmt.Fprintf(os.Stderr, "Warning: reloading config: %v\n", loadErr)
 			freshCfg = cfg
 		}
-		if renderErr := renderBoard(freshCfg, groupBy); renderErr != nil {
+		if renderErr := renderBoard(freshCfg, groupBy, mode); renderErr != nil {
 			fmt.Fprintf(os.Stderr, "Warning: rendering board: %v\n", renderErr)
 		}
-	})
+	}
+
+	w, err := watcher.New(watchPaths, render)
 	if err != nil {
 		return fmt.Errorf("starting file watcher: %w", err)
 	}
 	defer w.Close()
 
-	fmt.Fprintln(os.Stderr, "Watching for changes... (Ctrl+C to stop)")
+	// SIGHUP forces an immediate re-render, bypassing the debounce. Useful on
+	// network filesystems where fsnotify can miss events.
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				render()
+			}
+		}
+	}()
+
+	fmt.Fprintln(os.Stderr, "Watching for changes... (Ctrl+C to stop, SIGHUP to refresh)")
 
 	w.Run(ctx, func(watchErr error) {
 		fmt.Fprintf(os.Stderr, "Warning: file watcher: %v\n", watchErr)
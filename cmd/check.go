@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+)
+
+// checkExitBase is the exit code of the first health check; each later check
+// in board.HealthChecks order gets the next code.
+const checkExitBase = 3
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check board health for monitoring",
+	Long: `Evaluates the board's health: blocked tasks, overdue tasks, columns over
+their WIP limit, and stale claims (older than claim_timeout). Archived tasks
+are ignored.
+
+The exit code is 0 when every fatal check passes. Otherwise it identifies the
+first failing fatal check: 3 blocked, 4 overdue, 5 wip, 6 stale-claims. All
+checks are fatal unless --fail-on limits them; --json reports every check.`,
+	Args: cobra.NoArgs,
+	RunE: runCheck,
+}
+
+func init() {
+	checkCmd.Flags().StringSlice("fail-on", board.HealthChecks(),
+		"checks that fail the command ("+strings.Join(board.HealthChecks(), ", ")+")")
+	_ = checkCmd.RegisterFlagCompletionFunc("fail-on", cobra.FixedCompletions(board.HealthChecks(), cobra.ShellCompDirectiveNoFileComp))
+	rootCmd.AddCommand(checkCmd)
+}
+
+func runCheck(cmd *cobra.Command, _ []string) error {
+	failOn, _ := cmd.Flags().GetStringSlice("fail-on")
+	for _, name := range failOn {
+		if !slices.Contains(board.HealthChecks(), name) {
+			return clierr.Newf(clierr.InvalidInput, "invalid --fail-on check %q; valid: %s",
+				name, strings.Join(board.HealthChecks(), ", ")).
+				WithDetails(map[string]any{"check": name})
+		}
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	tasks, err := boardTasks(cfg, board.ArchivedDefault, true)
+	if err != nil {
+		return err
+	}
+	h := board.CheckHealth(cfg, tasks, failOn, time.Now())
+
+	if outputFormat() == output.FormatJSON {
+		if err := output.JSON(os.Stdout, h); err != nil {
+			return err
+		}
+	} else {
+		for _, c := range h.Checks {
+			fmt.Fprintln(os.Stdout, formatHealthCheck(c))
+		}
+	}
+
+	for i, c := range h.Checks {
+		if c.Failing && c.Fatal {
+			return &clierr.SilentError{Code: checkExitBase + i}
+		}
+	}
+	return nil
+}
+
+// formatHealthCheck renders one check as a line. Failing checks that are not
+// fatal are marked as warnings.
+func formatHealthCheck(c board.HealthCheck) string {
+	switch {
+	case !c.Failing:
+		return fmt.Sprintf("ok    %s", c.Name)
+	case c.Fatal:
+		return fmt.Sprintf("FAIL  %s (%d)", c.Name, c.Count)
+	default:
+		return fmt.Sprintf("warn  %s (%d)", c.Name, c.Count)
+	}
+}
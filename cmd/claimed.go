@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var claimedCmd = &cobra.Command{
+	Use:   "claimed",
+	Short: "List tasks an agent currently holds",
+	Long: `Lists the tasks claimed by an agent whose claims have not expired under
+claim_timeout, with the time left on each claim. Useful for an agent to
+reconcile its in-flight work after a restart.
+
+The agent defaults to $AGENTWATCH_AGENT, then $USER.`,
+	Args: cobra.NoArgs,
+	RunE: runClaimed,
+}
+
+func init() {
+	claimedCmd.Flags().String("by", "", "claimant name (default $AGENTWATCH_AGENT or $USER)")
+	_ = claimedCmd.RegisterFlagCompletionFunc("by", completeClaimants)
+	rootCmd.AddCommand(claimedCmd)
+}
+
+func runClaimed(cmd *cobra.Command, _ []string) error {
+	name, _ := cmd.Flags().GetString("by")
+	if name == "" {
+		name = board.DefaultActor()
+	}
+	if name == "" {
+		return clierr.New(clierr.InvalidInput, "claimant name is required (use --by NAME)")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	now := time.Now()
+	claims := board.ClaimsBy(tasks, name, cfg.ClaimTimeoutDuration(), now)
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, claims)
+	case output.FormatCompact:
+		output.ClaimCompact(os.Stdout, claims, now)
+	default:
+		output.ClaimTable(os.Stdout, claims, now)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var cloneCmd = &cobra.Command{
+	Use:   "clone ID",
+	Short: "Create a copy of a task",
+	Long: `Creates a new task from an existing one. The title (with a " (copy)"
+suffix unless --title is given), body, tags, priority, class and assignee are
+copied, and the clone starts in the source's status unless --status is given.
+
+Started, completed and claim fields are copied too; --reset-dates clears
+them. Use --depends-on-source to make the clone depend on the original.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClone,
+}
+
+func init() {
+	cloneCmd.Flags().String("title", "", "title of the clone")
+	cloneCmd.Flags().String("status", "", "status of the clone (default: the source's status)")
+	cloneCmd.Flags().Bool("reset-dates", false, "clear started, completed and claim fields")
+	cloneCmd.Flags().Bool("no-body", false, "do not copy the body")
+	cloneCmd.Flags().Bool("depends-on-source", false, "make the clone depend on the source task")
+	_ = cloneCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	rootCmd.AddCommand(cloneCmd)
+}
+
+func runClone(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return task.ValidateTaskID(args[0])
+	}
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := board.Lock(dir)
+	if err != nil {
+		return err
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := config.Load(dir)
+	if err != nil {
+		return err
+	}
+	src, err := readTaskByID(cfg, id)
+	if err != nil {
+		return err
+	}
+	t, err := cloneTask(cmd, cfg, src)
+	if err != nil {
+		return err
+	}
+	t.ID = cfg.NextID
+
+	if err := enforceCreateWIP(cfg, t); err != nil {
+		return err
+	}
+	path, err := writeNewTask(cfg, t)
+	if err != nil {
+		return err
+	}
+	cfg.NextID++
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	logActivity(cfg, "clone", t.ID, fmt.Sprintf("from #%d", src.ID))
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, t)
+	}
+	output.Messagef(os.Stdout, "Cloned task #%d as #%d: %s", src.ID, t.ID, t.Title)
+	output.Messagef(os.Stdout, "  File: %s", path)
+	return nil
+}
+
+// cloneTask builds the clone of src from the clone flags. The ID is assigned
+// by the caller.
+func cloneTask(cmd *cobra.Command, cfg *config.Config, src *task.Task) (*task.Task, error) {
+	now := time.Now()
+	t := &task.Task{Status: src.Status, Created: now, Updated: now}
+	copyTaskFields(t, src)
+
+	if title, _ := cmd.Flags().GetString("title"); title != "" {
+		t.Title = title
+	}
+	if status, _ := cmd.Flags().GetString("status"); status != "" {
+		resolved, err := task.ResolveStatus(cfg, status)
+		if err != nil {
+			return nil, err
+		}
+		t.Status = resolved
+	}
+	if noBody, _ := cmd.Flags().GetBool("no-body"); noBody {
+		t.Body = ""
+	}
+	if reset, _ := cmd.Flags().GetBool("reset-dates"); !reset {
+		t.Started, t.Completed = cloneTime(src.Started), cloneTime(src.Completed)
+		t.ClaimedBy, t.ClaimedAt = src.ClaimedBy, cloneTime(src.ClaimedAt)
+	}
+	if chain, _ := cmd.Flags().GetBool("depends-on-source"); chain {
+		t.DependsOn = []int{src.ID}
+	}
+	return t, nil
+}
+
+// cloneTime returns a copy of ts, or nil.
+func cloneTime(ts *time.Time) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	c := *ts
+	return &c
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment ID TEXT",
+	Short: "Add a comment to a task",
+	Long: `Appends a comment block with a timestamp and author to the task body.
+The author defaults to $AGENTWATCH_AGENT, then $USER. Claimed tasks can only
+be commented on by the claimant; --claim defaults to the author.
+
+Use "show ID --comments-only" to read the comments back.`,
+	Args: cobra.ExactArgs(2), //nolint:mnd // ID and text
+	RunE: runComment,
+}
+
+func init() {
+	commentCmd.Flags().String("author", "", "comment author (default $AGENTWATCH_AGENT or $USER)")
+	commentCmd.Flags().String("claim", "", "claimant name for claimed tasks (default the author)")
+	rootCmd.AddCommand(commentCmd)
+}
+
+func runComment(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return task.ValidateTaskID(args[0])
+	}
+	text := strings.TrimSpace(args[1])
+	if text == "" {
+		return clierr.New(clierr.InvalidInput, "comment text is required")
+	}
+
+	author, _ := cmd.Flags().GetString("author")
+	if author == "" {
+		author = defaultAuthor()
+	}
+	claimant, _ := cmd.Flags().GetString("claim")
+	if claimant == "" {
+		claimant = author
+	}
+	setActor(author)
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	// Comment headers have minute precision; match that in the JSON output.
+	c := task.Comment{Author: author, Timestamp: now.Truncate(time.Minute), Text: text}
+	var t *task.Task
+	err = withBoardLock(cfg, func() error {
+		path, err := task.FindByID(cfg.TasksPath(), id)
+		if err != nil {
+			return err
+		}
+		if t, err = task.Read(path); err != nil {
+			return err
+		}
+		if err = checkClaim(t, claimant, cfg.ClaimTimeoutDuration()); err != nil {
+			return err
+		}
+
+		task.AppendComment(t, c)
+		t.Updated = now
+		if err := checkBodySize(cfg, t); err != nil {
+			return err
+		}
+		if err := task.Write(path, t); err != nil {
+			return fmt.Errorf("writing task: %w", err)
+		}
+		logActivity(cfg, "comment", t.ID, author)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, c)
+	}
+	output.Messagef(os.Stdout, "Commented on task #%d: %s", t.ID, t.Title)
+	return nil
+}
+
+// defaultAuthor returns the agent name from $AGENTWATCH_AGENT, falling back
+// to $USER.
+func defaultAuthor() string {
+	if v := board.DefaultActor(); v != "" {
+		return v
+	}
+	return "unknown"
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// completionConfig loads the board config for shell completion. Unlike
+// loadConfig it never auto-creates a board, since pressing tab must not
+// write files.
+func completionConfig() (*config.Config, bool) {
+	dir, err := resolveDir()
+	if err != nil {
+		return nil, false
+	}
+	cfg, err := config.Load(dir)
+	if err != nil {
+		return nil, false
+	}
+	return cfg, true
+}
+
+// completeFromConfig builds a flag completion function from config values.
+func completeFromConfig(values func(*config.Config) []string) cobra.CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cfg, ok := completionConfig()
+		if !ok {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return completeListItem(values(cfg), toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeFixed builds a flag completion function from a fixed list.
+func completeFixed(values []string) cobra.CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completeListItem(values, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeListItem completes the last element of a comma-separated value, so
+// slice flags like --status todo,<tab> work.
+func completeListItem(values []string, toComplete string) []string {
+	i := strings.LastIndexByte(toComplete, ',')
+	if i < 0 {
+		return values
+	}
+	prefix := toComplete[:i+1]
+	out := make([]string, len(values))
+	for j, v := range values {
+		out[j] = prefix + v
+	}
+	return out
+}
+
+var (
+	completeStatuses   = completeFromConfig(func(c *config.Config) []string { return c.StatusNames() })
+	completePriorities = completeFromConfig(func(c *config.Config) []string { return c.PriorityNames() })
+	completeClasses    = completeFromConfig(func(c *config.Config) []string { return c.ClassNames() })
+	completeSortFields = completeFixed(board.ValidSortFields())
+	completeGroupBy    = completeFixed(board.ValidGroupByFields())
+	completeTaskFields = completeFixed(output.TaskFields())
+)
+
+// completeMoveArgs completes the STATUS argument of move.
+func completeMoveArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 1 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeStatuses(cmd, args, toComplete)
+}
+
+// completeAssignees offers existing assignees for --assignee flags.
+func completeAssignees(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return completeDistinct(board.DistinctAssignees)
+}
+
+// completeClaimants offers existing claimants for --claimed-by flags.
+func completeClaimants(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return completeDistinct(board.DistinctClaimants)
+}
+
+func completeDistinct(distinct func([]*task.Task) []board.ValueCount) ([]string, cobra.ShellCompDirective) {
+	cfg, ok := completionConfig()
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	values := distinct(tasks)
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		names = append(names, v.Value)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
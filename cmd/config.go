@@ -1,17 +1,24 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"reflect"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
 
 var configCmd = &cobra.Command{
@@ -24,20 +31,78 @@ var configCmd = &cobra.Command{
 var configGetCmd = &cobra.Command{
 	Use:   "get KEY",
 	Short: "Get a configuration value",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runConfigGet,
+	Long: `Prints one config value. Besides the keys listed by "config show", a
+path can reach into the statuses, classes and tui.age_thresholds lists:
+statuses and classes are addressed by name, age thresholds by index, e.g.
+  config get statuses.in-progress.require_claim
+  config get classes.expedite.wip_limit
+  config get tui.age_thresholds[0].color
+wip_limits.<status> and priority_wip_limits.<priority> read single limits.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigGet,
 }
 
 var configSetCmd = &cobra.Command{
 	Use:   "set KEY VALUE",
 	Short: "Set a configuration value",
-	Args:  cobra.ExactArgs(2), //nolint:mnd // key and value
-	RunE:  runConfigSet,
+	Long: `Sets a writable key in the board's config.yml.
+
+With --global, the value is written to the user-wide defaults file
+($XDG_CONFIG_HOME/agentwatch/defaults.yml, usually ~/.config/agentwatch),
+which applies to every board whose config.yml leaves the key unset. Only
+presentation and policy keys can be set globally: claim_timeout,
+tui.title_lines, tui.body_lines, tui.age_thresholds, limits.max_body_bytes
+and priority_colors. New boards leave these keys unset.
+
+priority_colors takes PRIORITY=COLOR pairs, e.g. "high=208,critical=196".`,
+	Args: cobra.ExactArgs(2), //nolint:mnd // key and value
+	RunE: runConfigSet,
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset KEY",
+	Short: "Reset a configuration value to its default",
+	Long: `Resets a writable key to its default value. Optional keys such as
+board.description, claim_timeout and auto_archive_after are cleared, so the
+global defaults file or the built-in default applies.
+With --global, the key is removed from the user-wide defaults file.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigUnset,
+}
+
+var configResetCmd = &cobra.Command{
+	Use:   "reset SECTION",
+	Short: "Restore a config section to its defaults",
+	Long: `Restores a whole config section to its defaults (the global defaults
+file, where it sets a key, or the built-in defaults):
+  tui             title lines, body lines and age thresholds
+  classes         the default classes of service
+  age_thresholds  tui.age_thresholds only`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: configResetSectionNames(),
+	RunE:      runConfigReset,
+}
+
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Migrate the config file to the current version",
+	Long: `Upgrades config.yml to the version this binary supports and saves it.
+Configs are normally migrated implicitly on load; this command makes the
+process visible. Use --dry-run to report the detected version and the
+migrations that would run, and print the resulting config without saving.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigMigrate,
 }
 
 func init() {
+	configSetCmd.Flags().Bool("global", false, "write to the user-wide defaults file")
+	configUnsetCmd.Flags().Bool("global", false, "remove from the user-wide defaults file")
+	configMigrateCmd.Flags().Bool("dry-run", false, "show the migrations and resulting config without saving")
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configResetCmd)
+	configCmd.AddCommand(configMigrateCmd)
 	rootCmd.AddCommand(configCmd)
 }
 
@@ -45,6 +110,7 @@ func init() {
 type configAccessor struct {
 	get      func(*config.Config) any
 	set      func(*config.Config, string) error
+	unset    func(*config.Config) // nil for keys without a default (e.g. board.name)
 	writable bool
 }
 
@@ -64,13 +130,14 @@ func baseConfigAccessors() map[string]configAccessor {
 		"board.description": {
 			get:      func(c *config.Config) any { return c.Board.Description },
 			set:      func(c *config.Config, v string) error { c.Board.Description = v; return nil },
+			unset:    func(c *config.Config) { c.Board.Description = "" },
 			writable: true,
 		},
 		"statuses": {
 			get: func(c *config.Config) any { return c.StatusNames() },
 		},
 		"priorities": {
-			get: func(c *config.Config) any { return c.Priorities },
+			get: func(c *config.Config) any { return c.PriorityNames() },
 		},
 		"defaults.status": {
 			get: func(c *config.Config) any { return c.Defaults.Status },
@@ -82,18 +149,20 @@ func baseConfigAccessors() map[string]configAccessor {
 				c.Defaults.Status = v
 				return nil
 			},
+			unset:    func(c *config.Config) { c.Defaults.Status = config.DefaultStatus },
 			writable: true,
 		},
 		"defaults.priority": {
 			get: func(c *config.Config) any { return c.Defaults.Priority },
 			set: func(c *config.Config, v string) error {
-				if config.IndexOf(c.Priorities, v) < 0 {
+				if c.PriorityIndex(v) < 0 {
 					return clierr.Newf(clierr.InvalidInput,
-						"invalid default priority %q; allowed: %s", v, strings.Join(c.Priorities, ", "))
+						"invalid default priority %q; allowed: %s", v, strings.Join(c.PriorityNames(), ", "))
 				}
 				c.Defaults.Priority = v
 				return nil
 			},
+			unset:    func(c *config.Config) { c.Defaults.Priority = config.DefaultPriority },
 			writable: true,
 		},
 		"tasks_dir": {
@@ -106,12 +175,13 @@ func baseConfigAccessors() map[string]configAccessor {
 			get: func(c *config.Config) any { return c.Version },
 		},
 		"wip_limits": {
-			get: func(c *config.Config) any {
-				if c.WIPLimits == nil {
-					return map[string]int{}
-				}
-				return c.WIPLimits
-			},
+			get: func(c *config.Config) any { return countMapOrEmpty(c.WIPLimits) },
+		},
+		"priority_wip_limits": {
+			get: func(c *config.Config) any { return countMapOrEmpty(c.PriorityWIPLimits) },
+		},
+		"wip_minimums": {
+			get: func(c *config.Config) any { return countMapOrEmpty(c.WIPMinimums) },
 		},
 	}
 }
@@ -131,10 +201,11 @@ func addExtendedConfigAccessors(accessors map[string]configAccessor) {
 			c.Defaults.Class = v
 			return nil
 		},
+		unset:    func(c *config.Config) { c.Defaults.Class = config.DefaultClass },
 		writable: true,
 	}
 	accessors["claim_timeout"] = configAccessor{
-		get: func(c *config.Config) any { return c.ClaimTimeout },
+		get: func(c *config.Config) any { return c.ClaimTimeoutValue() },
 		set: func(c *config.Config, v string) error {
 			if _, err := time.ParseDuration(v); err != nil {
 				return clierr.Newf(clierr.InvalidInput,
@@ -143,13 +214,53 @@ func addExtendedConfigAccessors(accessors map[string]configAccessor) {
 			c.ClaimTimeout = v
 			return nil
 		},
+		unset:    func(c *config.Config) { c.ClaimTimeout = "" },
+		writable: true,
+	}
+	accessors["auto_archive_after"] = configAccessor{
+		get: func(c *config.Config) any { return c.AutoArchiveAfter },
+		set: func(c *config.Config, v string) error {
+			if _, err := date.ParseDuration(v); err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid auto_archive_after %q: %v", v, err)
+			}
+			c.AutoArchiveAfter = v
+			return nil
+		},
+		unset:    func(c *config.Config) { c.AutoArchiveAfter = "" },
 		writable: true,
 	}
+	addListDefaultsAccessors(accessors)
 	accessors["classes"] = configAccessor{
 		get: func(c *config.Config) any { return c.Classes },
 	}
+	accessors["priority_colors"] = configAccessor{
+		get: func(c *config.Config) any {
+			colors := make(map[string]string, len(c.Priorities))
+			for _, p := range c.Priorities {
+				colors[p.Name] = c.PriorityColor(p.Name)
+			}
+			return colors
+		},
+		set: func(c *config.Config, v string) error {
+			colors, err := parsePriorityColors(c, v)
+			if err != nil {
+				return err
+			}
+			for i, p := range c.Priorities {
+				c.Priorities[i].Color = colors[p.Name]
+			}
+			return nil
+		},
+		unset: func(c *config.Config) {
+			for i := range c.Priorities {
+				c.Priorities[i].Color = ""
+			}
+		},
+		writable: true,
+	}
 	accessors["tui.title_lines"] = configAccessor{
-		get: func(c *config.Config) any { return c.TUI.TitleLines },
+		get: func(c *config.Config) any { return c.TitleLines() },
 		set: func(c *config.Config, v string) error {
 			n, err := strconv.Atoi(v)
 			if err != nil {
@@ -159,10 +270,21 @@ func addExtendedConfigAccessors(accessors map[string]configAccessor) {
 			c.TUI.TitleLines = n
 			return nil // validation handles range check
 		},
+		unset:    func(c *config.Config) { c.TUI.TitleLines = 0 },
 		writable: true,
 	}
 	accessors["tui.age_thresholds"] = configAccessor{
-		get: func(c *config.Config) any { return c.TUI.AgeThresholds },
+		get: func(c *config.Config) any { return c.AgeThresholds() },
+		set: func(c *config.Config, v string) error {
+			thresholds, err := parseAgeThresholds(v)
+			if err != nil {
+				return err
+			}
+			c.TUI.AgeThresholds = thresholds
+			return nil // validation handles durations and colors
+		},
+		unset:    func(c *config.Config) { c.TUI.AgeThresholds = nil },
+		writable: true,
 	}
 	accessors["tui.body_lines"] = configAccessor{
 		get: func(c *config.Config) any { return c.TUI.BodyLines },
@@ -175,10 +297,345 @@ func addExtendedConfigAccessors(accessors map[string]configAccessor) {
 			c.TUI.BodyLines = n
 			return nil // validation handles range check
 		},
+		unset:    func(c *config.Config) { c.TUI.BodyLines = 0 },
+		writable: true,
+	}
+	accessors["tasks.filename_format"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Tasks.FilenameFormat == "" {
+				return config.DefaultFilenameFormat
+			}
+			return c.Tasks.FilenameFormat
+		},
+		set: func(c *config.Config, v string) error {
+			c.Tasks.FilenameFormat = v
+			return nil // validation parses the template
+		},
+		unset:    func(c *config.Config) { c.Tasks.FilenameFormat = "" },
+		writable: true,
+	}
+	accessors["limits.max_body_bytes"] = configAccessor{
+		get: func(c *config.Config) any { return c.MaxBodyBytes() },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid limits.max_body_bytes %q: must be an integer", v)
+			}
+			c.Limits.MaxBodyBytes = n
+			return nil // validation handles range check
+		},
+		unset:    func(c *config.Config) { c.Limits.MaxBodyBytes = 0 },
+		writable: true,
+	}
+	accessors["limits.max_history"] = configAccessor{
+		get: func(c *config.Config) any { return c.MaxHistory() },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid limits.max_history %q: must be an integer", v)
+			}
+			c.Limits.MaxHistory = n
+			return nil // validation handles range check
+		},
+		unset:    func(c *config.Config) { c.Limits.MaxHistory = 0 },
+		writable: true,
+	}
+	accessors["log.max_files"] = configAccessor{
+		get: func(c *config.Config) any { return c.Log.MaxFiles },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid log.max_files %q: must be an integer", v)
+			}
+			c.Log.MaxFiles = n
+			return nil // validation handles range check
+		},
+		unset:    func(c *config.Config) { c.Log.MaxFiles = 0 },
+		writable: true,
+	}
+}
+
+func addListDefaultsAccessors(accessors map[string]configAccessor) {
+	accessors["defaults.list.sort"] = configAccessor{
+		get: func(c *config.Config) any { return c.Defaults.List.Sort },
+		set: func(c *config.Config, v string) error {
+			c.Defaults.List.Sort = v
+			return nil // validation checks the fields
+		},
+		unset:    func(c *config.Config) { c.Defaults.List.Sort = "" },
+		writable: true,
+	}
+	accessors["defaults.list.reverse"] = configAccessor{
+		get: func(c *config.Config) any { return c.Defaults.List.Reverse },
+		set: func(c *config.Config, v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid defaults.list.reverse %q: must be true or false", v)
+			}
+			c.Defaults.List.Reverse = b
+			return nil
+		},
+		unset:    func(c *config.Config) { c.Defaults.List.Reverse = false },
+		writable: true,
+	}
+	accessors["defaults.list.exclude_statuses"] = configAccessor{
+		get: func(c *config.Config) any {
+			if c.Defaults.List.ExcludeStatuses == nil {
+				return []string{}
+			}
+			return c.Defaults.List.ExcludeStatuses
+		},
+		set: func(c *config.Config, v string) error {
+			var statuses []string
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); s == "" {
+					continue
+				}
+				status, err := task.ResolveStatus(c, s)
+				if err != nil {
+					return err
+				}
+				statuses = append(statuses, status)
+			}
+			c.Defaults.List.ExcludeStatuses = statuses
+			return nil
+		},
+		unset:    func(c *config.Config) { c.Defaults.List.ExcludeStatuses = nil },
+		writable: true,
+	}
+	accessors["defaults.list.limit"] = configAccessor{
+		get: func(c *config.Config) any { return c.Defaults.List.Limit },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid defaults.list.limit %q: must be an integer", v)
+			}
+			c.Defaults.List.Limit = n
+			return nil // validation handles range check
+		},
+		unset:    func(c *config.Config) { c.Defaults.List.Limit = 0 },
+		writable: true,
+	}
+}
+
+// countMapKey describes a map-valued config key whose entries are addressed
+// as <prefix><name>, e.g. wip_limits.in-progress.
+type countMapKey struct {
+	prefix string
+	kind   string                        // "status" or "priority", for errors
+	names  func(*config.Config) []string // allowed entry names
+	field  func(*config.Config) *map[string]int
+}
+
+// countMapKeys lists the per-entry keys: column WIP limits, priority WIP
+// limits and replenishment minimums.
+var countMapKeys = []countMapKey{
+	{"wip_limits.", "status", (*config.Config).StatusNames,
+		func(c *config.Config) *map[string]int { return &c.WIPLimits }},
+	{"priority_wip_limits.", "priority", (*config.Config).PriorityNames,
+		func(c *config.Config) *map[string]int { return &c.PriorityWIPLimits }},
+	{"wip_minimums.", "status", (*config.Config).StatusNames,
+		func(c *config.Config) *map[string]int { return &c.WIPMinimums }},
+}
+
+// lookupConfigAccessor resolves a config key, including per-entry keys such
+// as wip_limits.<status> and priority_wip_limits.<priority>.
+func lookupConfigAccessor(cfg *config.Config, key string) (configAccessor, error) {
+	for _, mk := range countMapKeys {
+		name, ok := strings.CutPrefix(key, mk.prefix)
+		if !ok {
+			continue
+		}
+		if config.IndexOf(mk.names(cfg), name) < 0 {
+			code := clierr.InvalidStatus
+			if mk.kind == "priority" {
+				code = clierr.InvalidPriority
+			}
+			return configAccessor{}, clierr.Newf(code,
+				"unknown %s %q in %s; allowed: %s", mk.kind, name, key, strings.Join(mk.names(cfg), ", "))
+		}
+		return countMapAccessor(mk, name), nil
+	}
+	acc, ok := configAccessors()[key]
+	if !ok {
+		if _, err := resolveConfigPath(cfg, key); err != nil {
+			return configAccessor{}, err
+		}
+		return configAccessor{get: func(c *config.Config) any {
+			v, _ := resolveConfigPath(c, key)
+			return v
+		}}, nil
+	}
+	return acc, nil
+}
+
+// resolveConfigPath walks a path into the list-valued config keys: statuses
+// and classes by name, tui.age_thresholds by index, then an optional field,
+// e.g. statuses.review.require_claim or tui.age_thresholds[1].color. Brackets
+// are accepted in place of dots.
+func resolveConfigPath(cfg *config.Config, key string) (any, error) {
+	path := strings.NewReplacer("[", ".", "]", "").Replace(key)
+	var (
+		elem  any
+		field string
+	)
+	switch {
+	case strings.HasPrefix(path, "statuses."):
+		rest := strings.TrimPrefix(path, "statuses.")
+		i := slices.IndexFunc(cfg.Statuses, func(s config.StatusConfig) bool { return hasPathPrefix(rest, s.Name) })
+		if i < 0 {
+			return nil, clierr.Newf(clierr.InvalidInput, "unknown status in config path %q; allowed: %s",
+				key, strings.Join(cfg.StatusNames(), ", "))
+		}
+		elem, field = cfg.Statuses[i], strings.TrimPrefix(rest[len(cfg.Statuses[i].Name):], ".")
+	case strings.HasPrefix(path, "classes."):
+		rest := strings.TrimPrefix(path, "classes.")
+		i := slices.IndexFunc(cfg.Classes, func(c config.ClassConfig) bool { return hasPathPrefix(rest, c.Name) })
+		if i < 0 {
+			return nil, clierr.Newf(clierr.InvalidInput, "unknown class in config path %q; allowed: %s",
+				key, strings.Join(cfg.ClassNames(), ", "))
+		}
+		elem, field = cfg.Classes[i], strings.TrimPrefix(rest[len(cfg.Classes[i].Name):], ".")
+	case strings.HasPrefix(path, "tui.age_thresholds."):
+		idx, rest, _ := strings.Cut(strings.TrimPrefix(path, "tui.age_thresholds."), ".")
+		i, err := strconv.Atoi(idx)
+		if err != nil || i < 0 || i >= len(cfg.TUI.AgeThresholds) {
+			return nil, clierr.Newf(clierr.InvalidInput, "invalid index %q in config path %q: %d age thresholds",
+				idx, key, len(cfg.TUI.AgeThresholds))
+		}
+		elem, field = cfg.TUI.AgeThresholds[i], rest
+	default:
+		return nil, clierr.Newf(clierr.InvalidInput, "unknown config key %q", key)
+	}
+
+	if field == "" {
+		return elem, nil
+	}
+	v, names := yamlField(elem, field)
+	if names != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "unknown field %q in config path %q; allowed: %s",
+			field, key, strings.Join(names, ", "))
+	}
+	return v, nil
+}
+
+// hasPathPrefix reports whether path is name or starts with name followed by
+// a dot, so names containing dots still resolve.
+func hasPathPrefix(path, name string) bool {
+	rest, ok := strings.CutPrefix(path, name)
+	return ok && (rest == "" || rest[0] == '.')
+}
+
+// yamlField returns the field of struct v whose yaml name is name, with
+// pointers dereferenced. If there is no such field it returns the valid names
+// instead.
+func yamlField(v any, name string) (any, []string) {
+	rv := reflect.ValueOf(v)
+	var names []string
+	for i := range rv.NumField() {
+		tag, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("yaml"), ",")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		if tag != name {
+			names = append(names, tag)
+			continue
+		}
+		f := rv.Field(i)
+		if f.Kind() == reflect.Pointer {
+			if f.IsNil() {
+				return nil, nil
+			}
+			f = f.Elem()
+		}
+		return f.Interface(), nil
+	}
+	return nil, names
+}
+
+// countMapAccessor reads and writes one entry of a count map. Setting 0
+// removes the entry.
+func countMapAccessor(mk countMapKey, name string) configAccessor {
+	return configAccessor{
+		get: func(c *config.Config) any { return (*mk.field(c))[name] },
+		set: func(c *config.Config, v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return clierr.Newf(clierr.InvalidInput,
+					"invalid %s%s %q: must be an integer", mk.prefix, name, v)
+			}
+			setCount(mk.field(c), name, n)
+			return nil // validation handles range check
+		},
+		unset:    func(c *config.Config) { setCount(mk.field(c), name, 0) },
 		writable: true,
 	}
 }
 
+// countMapOrEmpty returns m, or an empty map so JSON shows {} rather than null.
+func countMapOrEmpty(m map[string]int) map[string]int {
+	if m == nil {
+		return map[string]int{}
+	}
+	return m
+}
+
+func setCount(m *map[string]int, name string, n int) {
+	if n == 0 {
+		delete(*m, name)
+		if len(*m) == 0 {
+			*m = nil
+		}
+		return
+	}
+	if *m == nil {
+		*m = make(map[string]int)
+	}
+	(*m)[name] = n
+}
+
+// parseAgeThresholds parses the compact "after:color,..." syntax, e.g.
+// "0s:242,1h:34,24h:226". An empty string clears the thresholds.
+func parseAgeThresholds(v string) ([]config.AgeThreshold, error) {
+	if strings.TrimSpace(v) == "" {
+		return nil, nil
+	}
+	var thresholds []config.AgeThreshold
+	for _, part := range strings.Split(v, ",") {
+		after, color, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok || after == "" || color == "" {
+			return nil, clierr.Newf(clierr.InvalidInput,
+				"invalid tui.age_thresholds entry %q: want DURATION:COLOR", part)
+		}
+		thresholds = append(thresholds, config.AgeThreshold{After: after, Color: color})
+	}
+	return thresholds, nil
+}
+
+// parsePriorityColors parses "PRIORITY=COLOR" pairs separated by commas.
+// Priorities not listed keep their built-in color.
+func parsePriorityColors(c *config.Config, v string) (map[string]string, error) {
+	colors := make(map[string]string)
+	for _, part := range strings.Split(v, ",") {
+		name, color, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || name == "" || color == "" {
+			return nil, clierr.Newf(clierr.InvalidInput,
+				"invalid priority_colors entry %q: want PRIORITY=COLOR", part)
+		}
+		if c.PriorityIndex(name) < 0 {
+			return nil, clierr.Newf(clierr.InvalidInput,
+				"unknown priority %q; allowed: %s", name, strings.Join(c.PriorityNames(), ", "))
+		}
+		colors[name] = color
+	}
+	return colors, nil
+}
+
 // allConfigKeys returns config keys in display order.
 func allConfigKeys() []string {
 	return []string{
@@ -186,17 +643,29 @@ func allConfigKeys() []string {
 		"board.name",
 		"board.description",
 		"tasks_dir",
+		"tasks.filename_format",
 		"statuses",
 		"priorities",
+		"priority_colors",
 		"defaults.status",
 		"defaults.priority",
 		"defaults.class",
+		"defaults.list.sort",
+		"defaults.list.reverse",
+		"defaults.list.exclude_statuses",
+		"defaults.list.limit",
 		"wip_limits",
+		"priority_wip_limits",
+		"wip_minimums",
 		"claim_timeout",
+		"auto_archive_after",
 		"classes",
 		"tui.title_lines",
 		"tui.body_lines",
 		"tui.age_thresholds",
+		"limits.max_body_bytes",
+		"limits.max_history",
+		"log.max_files",
 		"next_id",
 	}
 }
@@ -217,10 +686,17 @@ func runConfigShow(_ *cobra.Command, _ []string) error {
 		return output.JSON(os.Stdout, m)
 	}
 
-	// Table mode: key-value pairs.
+	// Table mode: key-value pairs, aligned on the longest key.
+	width := 0
+	for _, key := range allConfigKeys() {
+		width = max(width, len(key))
+	}
 	for _, key := range allConfigKeys() {
-		val := accessors[key].get(cfg)
-		fmt.Fprintf(os.Stdout, "%-20s %v\n", key, formatConfigValue(val))
+		val := formatConfigValue(accessors[key].get(cfg))
+		if cfg.Source(key) == config.SourceGlobal {
+			val += " (global)"
+		}
+		fmt.Fprintf(os.Stdout, "%-*s %v\n", width, key, val)
 	}
 	return nil
 }
@@ -232,10 +708,9 @@ func runConfigGet(_ *cobra.Command, args []string) error {
 	}
 
 	key := args[0]
-	accessors := configAccessors()
-	acc, ok := accessors[key]
-	if !ok {
-		return clierr.Newf(clierr.InvalidInput, "unknown config key %q", key)
+	acc, err := lookupConfigAccessor(cfg, key)
+	if err != nil {
+		return err
 	}
 
 	val := acc.get(cfg)
@@ -248,45 +723,352 @@ func runConfigGet(_ *cobra.Command, args []string) error {
 	return nil
 }
 
-func runConfigSet(_ *cobra.Command, args []string) error {
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	if global, _ := cmd.Flags().GetBool("global"); global {
+		return runConfigSetGlobal(args[0], args[1])
+	}
+
+	key, value := args[0], args[1]
+	var acc configAccessor
+	cfg, err := updateConfig(func(cfg *config.Config) error {
+		var err error
+		if acc, err = lookupConfigAccessor(cfg, key); err != nil {
+			return err
+		}
+		if !acc.writable {
+			return clierr.Newf(clierr.InvalidInput, "config key %q is read-only", key)
+		}
+		if err := acc.set(cfg, value); err != nil {
+			return err
+		}
+		cfg.Override(key)
+		return cfg.Validate()
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{"key": key, "value": acc.get(cfg)})
+	}
+
+	output.Messagef(os.Stdout, "Set %s = %v", key, formatConfigValue(acc.get(cfg)))
+	return nil
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	if global, _ := cmd.Flags().GetBool("global"); global {
+		return runConfigUnsetGlobal(args[0])
+	}
+
+	key := args[0]
+	var acc configAccessor
+	cfg, err := updateConfig(func(cfg *config.Config) error {
+		var err error
+		if acc, err = lookupConfigAccessor(cfg, key); err != nil {
+			return err
+		}
+		if !acc.writable {
+			return clierr.Newf(clierr.InvalidInput, "config key %q is read-only", key)
+		}
+		if acc.unset == nil {
+			return clierr.Newf(clierr.InvalidInput, "config key %q is required and cannot be unset", key)
+		}
+		acc.unset(cfg)
+		if err := cfg.Validate(); err != nil {
+			return clierr.Newf(clierr.InvalidInput, "cannot unset %s: %v", key, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{"key": key, "value": acc.get(cfg)})
+	}
+
+	if v := formatConfigValue(acc.get(cfg)); v != "" {
+		output.Messagef(os.Stdout, "Reset %s to %s", key, v)
+	} else {
+		output.Messagef(os.Stdout, "Unset %s", key)
+	}
+	return nil
+}
+
+// updateConfig loads the board config under the board lock, applies change
+// and saves the result, so a concurrent create cannot have its next_id
+// overwritten with a stale value. Returns the saved config.
+func updateConfig(change func(*config.Config) error) (*config.Config, error) {
+	// The first load creates the board if needed, so there is a directory
+	// to lock; the second reads the config fresh under the lock.
 	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	err = withBoardLock(cfg, func() error {
+		if cfg, err = loadConfig(); err != nil {
+			return err
+		}
+		if err := change(cfg); err != nil {
+			return err
+		}
+		// Keys cleared by the change fall back to the global layer.
+		global, err := config.LoadGlobal()
+		if err != nil {
+			return err
+		}
+		global.Merge(cfg)
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// globalConfigAccessor returns the accessor for a key that may be set in
+// the global defaults file.
+func globalConfigAccessor(key string) (configAccessor, error) {
+	if !config.IsGlobalKey(key) {
+		return configAccessor{}, clierr.Newf(clierr.InvalidInput,
+			"config key %q cannot be set globally; global keys: %s", key, strings.Join(config.GlobalKeys(), ", "))
+	}
+	return configAccessors()[key], nil
+}
+
+// runConfigSetGlobal writes a key to the global defaults file after
+// validating it on top of the built-in defaults.
+func runConfigSetGlobal(key, value string) error {
+	acc, err := globalConfigAccessor(key)
+	if err != nil {
+		return err
+	}
+	global, err := config.LoadGlobal()
 	if err != nil {
 		return err
 	}
 
-	key, value := args[0], args[1]
-	accessors := configAccessors()
-	acc, ok := accessors[key]
+	preview := global.Preview()
+	if err := acc.set(preview, value); err != nil {
+		return err
+	}
+	if err := preview.Validate(); err != nil {
+		return err
+	}
+	global.SetFrom(key, preview)
+	if err := global.Save(); err != nil {
+		return fmt.Errorf("saving global defaults: %w", err)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{"key": key, "value": acc.get(preview), "file": global.Path()})
+	}
+	output.Messagef(os.Stdout, "Set %s = %v in %s", key, formatConfigValue(acc.get(preview)), global.Path())
+	return nil
+}
+
+// runConfigUnsetGlobal removes a key from the global defaults file.
+func runConfigUnsetGlobal(key string) error {
+	if _, err := globalConfigAccessor(key); err != nil {
+		return err
+	}
+	global, err := config.LoadGlobal()
+	if err != nil {
+		return err
+	}
+
+	global.Unset(key)
+	if err := global.Save(); err != nil {
+		return fmt.Errorf("saving global defaults: %w", err)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{"key": key, "file": global.Path()})
+	}
+	output.Messagef(os.Stdout, "Unset %s in %s", key, global.Path())
+	return nil
+}
+
+// configResetSection restores a group of keys to their defaults. Keys the
+// global defaults file can provide are cleared, so that file or the built-in
+// default applies.
+type configResetSection struct {
+	keys  []string // keys shown after the reset
+	reset func(*config.Config)
+}
+
+var configResetSections = map[string]configResetSection{
+	"tui": {
+		keys:  []string{"tui.title_lines", "tui.body_lines", "tui.age_thresholds"},
+		reset: func(c *config.Config) { c.TUI = config.TUIConfig{} },
+	},
+	"classes": {
+		keys:  []string{"classes"},
+		reset: func(c *config.Config) { c.Classes = append([]config.ClassConfig{}, config.DefaultClasses...) },
+	},
+	"age_thresholds": {
+		keys:  []string{"tui.age_thresholds"},
+		reset: func(c *config.Config) { c.TUI.AgeThresholds = nil },
+	},
+}
+
+func configResetSectionNames() []string {
+	return []string{"tui", "classes", "age_thresholds"}
+}
+
+func runConfigReset(_ *cobra.Command, args []string) error {
+	name := args[0]
+	section, ok := configResetSections[name]
 	if !ok {
-		return clierr.Newf(clierr.InvalidInput, "unknown config key %q", key)
+		return clierr.Newf(clierr.InvalidInput, "unknown config section %q; valid: %s",
+			name, strings.Join(configResetSectionNames(), ", "))
+	}
+
+	cfg, err := updateConfig(func(cfg *config.Config) error {
+		section.reset(cfg)
+		for _, key := range section.keys {
+			cfg.Override(key)
+		}
+		if err := cfg.Validate(); err != nil {
+			return clierr.Newf(clierr.InvalidInput, "cannot reset %s: %v", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	accessors := configAccessors()
+	if outputFormat() == output.FormatJSON {
+		m := make(map[string]any, len(section.keys))
+		for _, key := range section.keys {
+			m[key] = accessors[key].get(cfg)
+		}
+		return output.JSON(os.Stdout, map[string]any{"section": name, "values": m})
 	}
-	if !acc.writable {
-		return clierr.Newf(clierr.InvalidInput, "config key %q is read-only", key)
+
+	output.Messagef(os.Stdout, "Reset %s to defaults", name)
+	for _, key := range section.keys {
+		fmt.Fprintf(os.Stdout, "%-22s %v\n", key, formatConfigValue(accessors[key].get(cfg)))
 	}
+	return nil
+}
 
-	if err := acc.set(cfg, value); err != nil {
+// configMigrateResult is the JSON output of config migrate.
+type configMigrateResult struct {
+	FromVersion int      `json:"from_version"`
+	ToVersion   int      `json:"to_version"`
+	Steps       []string `json:"steps"`
+	DryRun      bool     `json:"dry_run,omitempty"`
+	Saved       bool     `json:"saved"`
+	Config      string   `json:"config,omitempty"`
+}
+
+func runConfigMigrate(cmd *cobra.Command, _ []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	dir, err := resolveDir()
+	if err != nil {
 		return err
 	}
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		return config.ErrNotFound
+	}
 
-	if err := cfg.Validate(); err != nil {
+	var (
+		res  configMigrateResult
+		path []int
+	)
+	err = board.WithLock(dir, func() error {
+		var err error
+		res, path, err = migrateConfig(dir, dryRun)
+		return err
+	})
+	if err != nil {
 		return err
 	}
+	return outputConfigMigrate(res, path)
+}
 
-	if err := cfg.Save(); err != nil {
-		return fmt.Errorf("saving config: %w", err)
+// migrateConfig migrates the board config in dir to the current version and
+// saves it unless dryRun is set. The caller must hold the board lock.
+func migrateConfig(dir string, dryRun bool) (configMigrateResult, []int, error) {
+	// Load the raw file: loadConfig would already have migrated and saved it.
+	cfg, err := config.LoadRaw(dir)
+	if err != nil {
+		return configMigrateResult{}, nil, err
 	}
 
+	fromVersion := cfg.Version
+	path, err := config.MigrationPath(fromVersion)
+	if err != nil {
+		return configMigrateResult{}, nil, err
+	}
+	steps := make([]string, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		steps = append(steps, fmt.Sprintf("v%d→v%d", path[i-1], path[i]))
+	}
+
+	if err := config.Migrate(cfg); err != nil {
+		return configMigrateResult{}, nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return configMigrateResult{}, nil, err
+	}
+
+	res := configMigrateResult{FromVersion: fromVersion, ToVersion: cfg.Version, Steps: steps, DryRun: dryRun}
+	if dryRun {
+		data, err := cfg.Marshal()
+		if err != nil {
+			return configMigrateResult{}, nil, err
+		}
+		res.Config = string(data)
+	} else if len(steps) > 0 {
+		if err := cfg.Save(); err != nil {
+			return configMigrateResult{}, nil, fmt.Errorf("saving config: %w", err)
+		}
+		res.Saved = true
+	}
+	return res, path, nil
+}
+
+func outputConfigMigrate(res configMigrateResult, path []int) error {
 	if outputFormat() == output.FormatJSON {
-		return output.JSON(os.Stdout, map[string]any{"key": key, "value": acc.get(cfg)})
+		return output.JSON(os.Stdout, res)
 	}
 
-	output.Messagef(os.Stdout, "Set %s = %v", key, formatConfigValue(acc.get(cfg)))
+	if len(res.Steps) == 0 {
+		output.Messagef(os.Stdout, "Config is already at version %d", res.ToVersion)
+		if res.DryRun {
+			fmt.Fprint(os.Stdout, res.Config)
+		}
+		return nil
+	}
+
+	chain := make([]string, len(path))
+	for i, v := range path {
+		chain[i] = "v" + strconv.Itoa(v)
+	}
+	if res.DryRun {
+		fmt.Fprintf(os.Stdout, "Detected config version %d\n", res.FromVersion)
+		fmt.Fprintf(os.Stdout, "Would migrate: %s\n\n", strings.Join(chain, "→"))
+		fmt.Fprint(os.Stdout, res.Config)
+		return nil
+	}
+	output.Messagef(os.Stdout, "Migrated config: %s", strings.Join(chain, "→"))
 	return nil
 }
 
 func formatConfigValue(val any) string {
 	switch v := val.(type) {
 	case []string:
+		if len(v) == 0 {
+			return "--"
+		}
 		return strings.Join(v, ", ")
 	case map[string]int:
 		if len(v) == 0 {
@@ -296,8 +1078,47 @@ func formatConfigValue(val any) string {
 		for k, n := range v {
 			parts = append(parts, fmt.Sprintf("%s=%d", k, n))
 		}
+		sort.Strings(parts)
+		return strings.Join(parts, ", ")
+	case map[string]string:
+		if len(v) == 0 {
+			return "--"
+		}
+		parts := make([]string, 0, len(v))
+		for k, s := range v {
+			parts = append(parts, k+"="+s)
+		}
+		sort.Strings(parts)
 		return strings.Join(parts, ", ")
+	case []config.AgeThreshold:
+		if len(v) == 0 {
+			return "--"
+		}
+		parts := make([]string, len(v))
+		for i, at := range v {
+			parts[i] = at.After + ":" + at.Color
+		}
+		return strings.Join(parts, ", ")
+	case nil:
+		return "--"
 	default:
+		if reflect.ValueOf(v).Kind() == reflect.Struct {
+			return formatConfigStruct(v)
+		}
 		return fmt.Sprintf("%v", v)
 	}
 }
+
+// formatConfigStruct renders a list element such as a status as
+// "name=value" pairs of its set fields, in yaml names.
+func formatConfigStruct(v any) string {
+	rv := reflect.ValueOf(v)
+	var parts []string
+	for i := range rv.NumField() {
+		tag, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("yaml"), ",")
+		if f := rv.Field(i); tag != "" && tag != "-" && !f.IsZero() {
+			parts = append(parts, tag+"="+formatConfigValue(reflect.Indirect(f).Interface()))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var configStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Add, remove, rename, or reorder statuses",
+	Long: `Changes the board's status columns. Every change re-validates the
+config and rewrites affected task files before saving. The archived status
+cannot be changed and always stays last.`,
+}
+
+var configStatusAddCmd = &cobra.Command{
+	Use:   "add NAME",
+	Short: "Add a status",
+	Long: `Adds a status after --after, or before archived (at the end if the
+board has no archived status).`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigStatusAdd,
+}
+
+var configStatusRemoveCmd = &cobra.Command{
+	Use:   "remove NAME",
+	Short: "Remove a status",
+	Long: `Removes a status. The column must be empty unless --move-to is given,
+in which case its tasks are moved to that status first, as by the move
+command: the target's WIP limits and require_claim apply, and timestamps
+and history are updated. If any task cannot be moved, nothing changes.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigStatusRemove,
+}
+
+var configStatusRenameCmd = &cobra.Command{
+	Use:   "rename OLD NEW",
+	Short: "Rename a status and every task in it",
+	Long: `Renames a status, updating the tasks in it and the status name in
+their recorded history. Tasks keep their timestamps; the rename is logged
+once rather than as a move per task.`,
+	Args: cobra.ExactArgs(2), //nolint:mnd // old and new name
+	RunE: runConfigStatusRename,
+}
+
+var configStatusMoveCmd = &cobra.Command{
+	Use:   "move NAME",
+	Short: "Move a status to another position",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigStatusMove,
+}
+
+func init() {
+	configStatusAddCmd.Flags().String("after", "", "insert after this status")
+	configStatusAddCmd.Flags().Bool("require-claim", false, "require a claim to move tasks into this status")
+	configStatusAddCmd.Flags().Bool("terminal", false, "mark the status as a done status")
+	configStatusAddCmd.Flags().Int("wip", 0, "WIP limit for the new status (0 = unlimited)")
+	configStatusAddCmd.RegisterFlagCompletionFunc("after", completeStatuses) //nolint:errcheck,gosec // flag exists
+
+	configStatusRemoveCmd.Flags().String("move-to", "", "move the status's tasks here before removing it")
+	configStatusRemoveCmd.RegisterFlagCompletionFunc("move-to", completeStatuses) //nolint:errcheck,gosec // flag exists
+
+	configStatusMoveCmd.Flags().Int("to", 0, "new 1-based position")
+	configStatusMoveCmd.MarkFlagRequired("to") //nolint:errcheck,gosec // flag exists
+
+	configStatusCmd.AddCommand(configStatusAddCmd)
+	configStatusCmd.AddCommand(configStatusRemoveCmd)
+	configStatusCmd.AddCommand(configStatusRenameCmd)
+	configStatusCmd.AddCommand(configStatusMoveCmd)
+	configCmd.AddCommand(configStatusCmd)
+}
+
+// statusChange describes the task rewrite a status change needs: tasks in
+// From move to To. With Rename the tasks keep their place in the flow and
+// only the name changes, including in their history; otherwise each task is
+// moved like by the move command. check, if non-nil, is called with the
+// number of affected tasks before anything is written and may veto the
+// change. Message builds the success message from the number of rewritten
+// tasks.
+type statusChange struct {
+	From, To string
+	Rename   bool
+	Reason   string
+	Check    func(int) error
+	Message  func(int) string
+}
+
+func runConfigStatusAdd(cmd *cobra.Command, args []string) error {
+	after, _ := cmd.Flags().GetString("after")
+	requireClaim, _ := cmd.Flags().GetBool("require-claim")
+	terminal, _ := cmd.Flags().GetBool("terminal")
+	wip, _ := cmd.Flags().GetInt("wip")
+	if wip < 0 {
+		return clierr.Newf(clierr.InvalidInput, "--wip must not be negative, got %d", wip)
+	}
+
+	return updateStatuses(func(cfg *config.Config) (statusChange, error) {
+		s := config.StatusConfig{Name: args[0], RequireClaim: requireClaim, Terminal: terminal}
+		if err := cfg.AddStatus(s, after, wip); err != nil {
+			return statusChange{}, err
+		}
+		return statusChange{Message: func(int) string { return "Added status " + args[0] }}, nil
+	})
+}
+
+func runConfigStatusRemove(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	moveTo, _ := cmd.Flags().GetString("move-to")
+
+	return updateStatuses(func(cfg *config.Config) (statusChange, error) {
+		if moveTo == name {
+			return statusChange{}, clierr.New(clierr.InvalidInput, "--move-to must name a different status")
+		}
+		if moveTo != "" && cfg.StatusIndex(moveTo) < 0 {
+			return statusChange{}, clierr.Newf(clierr.InvalidStatus, "unknown status %q", moveTo)
+		}
+		if err := cfg.RemoveStatus(name); err != nil {
+			return statusChange{}, err
+		}
+
+		return statusChange{
+			From:   name,
+			To:     moveTo,
+			Reason: "status " + name + " removed",
+			Check: func(n int) error {
+				if moveTo == "" {
+					return clierr.Newf(clierr.StatusConflict, "status %q still has %d task(s)", name, n).
+						WithDetails(map[string]any{"status": name, "count": n})
+				}
+				return nil
+			},
+			Message: func(moved int) string {
+				if moved > 0 {
+					return fmt.Sprintf("Removed status %s (moved %d task(s) to %s)", name, moved, moveTo)
+				}
+				return "Removed status " + name
+			},
+		}, nil
+	})
+}
+
+func runConfigStatusRename(_ *cobra.Command, args []string) error {
+	oldName, newName := args[0], args[1]
+
+	return updateStatuses(func(cfg *config.Config) (statusChange, error) {
+		if err := cfg.RenameStatus(oldName, newName); err != nil {
+			return statusChange{}, err
+		}
+		return statusChange{
+			From:   oldName,
+			To:     newName,
+			Rename: true,
+			Message: func(n int) string {
+				return fmt.Sprintf("Renamed status %s to %s (%d task(s) updated)", oldName, newName, n)
+			},
+		}, nil
+	})
+}
+
+func runConfigStatusMove(cmd *cobra.Command, args []string) error {
+	to, _ := cmd.Flags().GetInt("to")
+
+	return updateStatuses(func(cfg *config.Config) (statusChange, error) {
+		if err := cfg.MoveStatus(args[0], to); err != nil {
+			return statusChange{}, err
+		}
+		return statusChange{Message: func(int) string {
+			return fmt.Sprintf("Moved status %s to position %d", args[0], to)
+		}}, nil
+	})
+}
+
+// updateStatuses applies a status change to the config under the board lock
+// and validates it before any task file is touched. Then the affected tasks
+// are rewritten and the config is saved; if either fails, the rewritten task
+// files are restored.
+func updateStatuses(change func(*config.Config) (statusChange, error)) error {
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+
+	unlock, err := board.Lock(dir)
+	if err != nil {
+		return err
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := config.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	// Keep the statuses as they were so timestamps are updated against the
+	// removed status's place in the flow.
+	prev := *cfg
+	prev.Statuses = slices.Clone(cfg.Statuses)
+
+	sc, err := change(cfg)
+	if err != nil {
+		if errors.Is(err, config.ErrInvalid) {
+			return clierr.New(clierr.InvalidStatus, strings.TrimPrefix(err.Error(), config.ErrInvalid.Error()+": "))
+		}
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return clierr.New(clierr.InvalidInput, err.Error())
+	}
+
+	rw, err := rewriteTaskStatus(cfg, &prev, sc)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Save(); err != nil {
+		rw.rollback()
+		return fmt.Errorf("saving config: %w", err)
+	}
+	if sc.Rename {
+		// A rename is not a flow transition, so it is logged once for the
+		// board rather than as a move per task.
+		logActivity(cfg, "rename-status", 0, fmt.Sprintf("%s -> %s", sc.From, sc.To))
+	} else {
+		for _, t := range rw.tasks {
+			logActivityWithReason(cfg, "move", t.ID, fmt.Sprintf("%s -> %s", sc.From, sc.To), sc.Reason)
+		}
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, cfg.Statuses)
+	}
+	output.Messagef(os.Stdout, "%s", sc.Message(len(rw.tasks)))
+	fmt.Fprintln(os.Stdout, "Statuses:", strings.Join(cfg.StatusNames(), ", "))
+	return nil
+}
+
+// statusRewrite records the task files rewritten by rewriteTaskStatus and
+// their original content.
+type statusRewrite struct {
+	tasks    []*task.Task
+	original [][]byte
+}
+
+// rollback restores every rewritten task file, best effort.
+func (rw statusRewrite) rollback() {
+	for i, t := range rw.tasks {
+		task.Restore(t.File, rw.original[i]) //nolint:errcheck,gosec // best-effort restore, already failing
+	}
+}
+
+// rewriteTaskStatus moves every task in sc.From to sc.To. sc.Check may veto
+// the rewrite before anything is written. Task files are read strictly so a
+// malformed file aborts before any task is modified; if a check or write
+// fails, the files already rewritten are restored. prev is the config before
+// the change, against which timestamps are updated.
+func rewriteTaskStatus(cfg, prev *config.Config, sc statusChange) (statusRewrite, error) {
+	var rw statusRewrite
+	if sc.From == "" {
+		return rw, nil
+	}
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		return rw, err
+	}
+
+	var affected []*task.Task
+	for _, t := range tasks {
+		if t.Status == sc.From {
+			affected = append(affected, t)
+		}
+	}
+	if sc.Check != nil && len(affected) > 0 {
+		if err := sc.Check(len(affected)); err != nil {
+			return rw, err
+		}
+	}
+
+	now := time.Now()
+	for _, t := range affected {
+		data, err := os.ReadFile(t.File)
+		if err != nil {
+			rw.rollback()
+			return statusRewrite{}, fmt.Errorf("reading task #%d: %w", t.ID, err)
+		}
+		if sc.Rename {
+			renameHistoryStatus(t, sc.From, sc.To)
+		} else {
+			// Checked one task at a time so the WIP count includes the
+			// tasks already moved.
+			if err := checkStatusMove(cfg, t, sc.To); err != nil {
+				rw.rollback()
+				return statusRewrite{}, err
+			}
+			task.UpdateTimestamps(t, sc.From, sc.To, prev)
+			recordTransition(cfg, t, sc.From, sc.To)
+		}
+		t.Status = sc.To
+		t.Updated = now
+		if err := task.Write(t.File, t); err != nil {
+			rw.rollback()
+			return statusRewrite{}, fmt.Errorf("writing task #%d: %w", t.ID, err)
+		}
+		rw.tasks = append(rw.tasks, t)
+		rw.original = append(rw.original, data)
+	}
+	return rw, nil
+}
+
+// checkStatusMove applies the move command's checks for moving t into
+// status: an active claim when the status requires one, and WIP limits.
+func checkStatusMove(cfg *config.Config, t *task.Task, status string) error {
+	if cfg.StatusRequiresClaim(status) && board.IsUnclaimed(t, cfg.ClaimTimeoutDuration()) {
+		return clierr.Newf(clierr.ClaimRequired, "status %q requires a claim, but task #%d is not claimed", status, t.ID).
+			WithDetails(map[string]any{"status": status, "id": t.ID})
+	}
+	if err := board.EnforceWIP(cfg, t, t.Status, status); err != nil {
+		return fmt.Errorf("moving task #%d: %w", t.ID, err)
+	}
+	return nil
+}
+
+// renameHistoryStatus rewrites oldName to newName in t's recorded
+// transitions.
+func renameHistoryStatus(t *task.Task, oldName, newName string) {
+	for i := range t.History {
+		h := &t.History[i]
+		if h.From == oldName {
+			h.From = newName
+		}
+		if h.To == oldName {
+			h.To = newName
+		}
+	}
+}
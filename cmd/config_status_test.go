@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// newStatusBoard creates a board whose tasks are in the given statuses, each
+// with one recorded transition into it from backlog.
+func newStatusBoard(t *testing.T, setup func(*config.Config), statuses ...string) (string, *config.Config) {
+	t.Helper()
+	root, cfg := newTestBoard(t, setup)
+	moved := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	for i, status := range statuses {
+		tk := &task.Task{
+			ID: i + 1, Title: "task", Status: status, Priority: "medium",
+			Created: moved, Updated: moved, Started: &moved,
+			History: []task.Transition{{At: moved, From: "backlog", To: status}},
+		}
+		if err := task.Write(filepath.Join(cfg.TasksPath(), task.FilenameFor(cfg, tk)), tk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root, cfg
+}
+
+func readBoardTasks(t *testing.T, cfg *config.Config) []*task.Task {
+	t.Helper()
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tasks
+}
+
+func TestConfigStatusRemoveMovesTasks(t *testing.T) {
+	root, cfg := newStatusBoard(t, nil, "review", "in-progress")
+
+	if out, err := runCLI(t, root, "config", "status", "remove", "review", "--move-to", "done"); err != nil {
+		t.Fatalf("remove: %v\n%s", err, out)
+	}
+
+	moved := readBoardTasks(t, cfg)[0]
+	if moved.Status != "done" || moved.Completed == nil {
+		t.Errorf("task #1 status %q, completed %v; want done with a completion time", moved.Status, moved.Completed)
+	}
+	if last := moved.History[len(moved.History)-1]; last.From != "review" || last.To != "done" {
+		t.Errorf("last transition = %s -> %s, want review -> done", last.From, last.To)
+	}
+	log, err := board.ReadLog(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.ContainsFunc(log, func(e board.LogEntry) bool {
+		return e.Action == "move" && e.TaskID == 1 && e.Detail == "review -> done"
+	}) {
+		t.Errorf("log %+v has no move for task #1", log)
+	}
+}
+
+func TestConfigStatusRemoveChecksTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(*config.Config)
+		wantErr string
+	}{
+		{
+			"wip limit",
+			func(c *config.Config) { c.WIPLimits = map[string]int{"todo": 2} },
+			"WIP limit",
+		},
+		{
+			"require claim",
+			func(c *config.Config) { c.Statuses[c.StatusIndex("todo")].RequireClaim = true },
+			"requires a claim",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, cfg := newStatusBoard(t, tt.setup, "review", "review", "todo")
+
+			out, err := runCLI(t, root, "config", "status", "remove", "review", "--move-to", "todo")
+			if err == nil || !strings.Contains(string(out), tt.wantErr) {
+				t.Fatalf("remove = %v\n%s; want %q error", err, out, tt.wantErr)
+			}
+			for _, tk := range readBoardTasks(t, cfg) {
+				if want := []string{"", "review", "review", "todo"}[tk.ID]; tk.Status != want {
+					t.Errorf("task #%d status %q, want %q (rolled back)", tk.ID, tk.Status, want)
+				}
+			}
+			saved, err := config.Load(cfg.Dir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved.StatusIndex("review") < 0 {
+				t.Error("review was removed despite the failed move")
+			}
+		})
+	}
+}
+
+func TestConfigStatusRenameKeepsFlow(t *testing.T) {
+	root, cfg := newStatusBoard(t, nil, "review")
+
+	if out, err := runCLI(t, root, "config", "status", "rename", "review", "qa"); err != nil {
+		t.Fatalf("rename: %v\n%s", err, out)
+	}
+
+	tk := readBoardTasks(t, cfg)[0]
+	if tk.Status != "qa" || len(tk.History) != 1 || tk.History[0].To != "qa" || tk.Completed != nil {
+		t.Errorf("task = status %q, history %+v, completed %v; want qa with the renamed transition only",
+			tk.Status, tk.History, tk.Completed)
+	}
+	log, err := board.ReadLog(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actions []string
+	for _, e := range log {
+		actions = append(actions, e.Action)
+	}
+	if !slices.Equal(actions, []string{"rename-status"}) {
+		t.Errorf("log actions = %q, want one rename-status", actions)
+	}
+}
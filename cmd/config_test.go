@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestConfigUnsetRestoresNewDefault(t *testing.T) {
+	accessors := configAccessors()
+	tests := []struct {
+		key   string
+		value string
+	}{
+		{"claim_timeout", "3h"},
+		{"defaults.class", "expedite"},
+		{"defaults.status", "todo"},
+		{"defaults.priority", "high"},
+		{"tui.title_lines", "4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			acc := accessors[tt.key]
+			cfg := config.NewDefault("test")
+			want := acc.get(cfg)
+
+			if err := acc.set(cfg, tt.value); err != nil {
+				t.Fatal(err)
+			}
+			acc.unset(cfg)
+
+			if got := acc.get(cfg); !reflect.DeepEqual(got, want) {
+				t.Errorf("after unset %s = %v, want %v", tt.key, got, want)
+			}
+		})
+	}
+}
+
+func TestConfigSetDuringCreatesKeepsNextID(t *testing.T) {
+	const racers = 6
+	root, cfg := newTestBoard(t, nil)
+
+	var wg sync.WaitGroup
+	for i := range racers {
+		wg.Go(func() {
+			if out, err := runCLI(t, root, "create", "racer"); err != nil {
+				t.Errorf("create: %v: %s", err, out)
+			}
+		})
+		wg.Go(func() {
+			if out, err := runCLI(t, root, "config", "set", "tui.title_lines", strconv.Itoa(i%3+1)); err != nil {
+				t.Errorf("config set: %v: %s", err, out)
+			}
+		})
+	}
+	wg.Wait()
+
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[int]bool{}
+	for _, tk := range tasks {
+		if seen[tk.ID] {
+			t.Errorf("duplicate task ID %d", tk.ID)
+		}
+		seen[tk.ID] = true
+	}
+	saved, err := config.Load(cfg.Dir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != racers || saved.NextID != racers+1 {
+		t.Errorf("%d tasks, next_id %d; want %d and %d", len(tasks), saved.NextID, racers, racers+1)
+	}
+}
+
+func TestConfigShowUsesGlobalDefaults(t *testing.T) {
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	global := filepath.Join(xdg, "agentwatch", config.GlobalFileName)
+	if err := os.MkdirAll(filepath.Dir(global), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	content := "claim_timeout: 5m\ntui:\n  title_lines: 3\npriority_colors:\n  high: \"99\"\n"
+	if err := os.WriteFile(global, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// No board yet: the first command creates one.
+	root := t.TempDir()
+
+	steps := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"config", "show"}, []string{"5m (global)", "3 (global)", "high=99"}},
+		{[]string{"config", "set", "claim_timeout", "2h"}, nil},
+		{[]string{"config", "get", "claim_timeout"}, []string{"2h"}},
+		{[]string{"config", "unset", "claim_timeout"}, []string{"5m"}},
+		{[]string{"config", "reset", "tui"}, []string{"tui.title_lines        3"}},
+	}
+	for _, st := range steps {
+		out, err := runCLI(t, root, st.args...)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", strings.Join(st.args, " "), err, out)
+		}
+		for _, want := range st.want {
+			if !strings.Contains(string(out), want) {
+				t.Errorf("%s output lacks %q:\n%s", strings.Join(st.args, " "), want, out)
+			}
+		}
+	}
+}
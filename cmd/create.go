@@ -5,16 +5,18 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
-	"github.com/twiced-technology-gmbh/agentwatch/internal/filelock"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
@@ -26,7 +28,25 @@ var createCmd = &cobra.Command{
 	Long: `Creates a new task file with the given title and optional fields.
 
 Title can be provided as a positional argument or via --title flag.
-Body/description can be provided via --body or --description flag.`,
+Body/description can be provided via --body or --description flag, or piped
+verbatim through stdin with --stdin-body.
+
+With --from ID, the new task starts as a copy of an existing one: title (with
+a " (copy)" suffix unless a title is given), body, tags, priority, class and
+assignee are copied, and any other flags override them. Status, timestamps
+and claims are not copied.
+
+With --id N, the task is created with that ID instead of the next free one,
+e.g. to preserve IDs when migrating between boards. The ID must not be in use;
+next_id is raised past it.
+
+With --stdin, tasks are read as newline-delimited JSON objects, one per line:
+  {"title": "...", "status": "...", "priority": "...", "tags": [...],
+   "body": "...", "parent": 7, "depends_on": [3]}
+A negative parent or dependency refers to a task created earlier in the same
+input: -1 is the task from the previous line, -2 the one before that.
+Valid lines are created even if other lines fail; failures are reported
+per line.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runCreate,
 }
@@ -34,6 +54,8 @@ Body/description can be provided via --body or --description flag.`,
 func init() {
 	createCmd.Flags().String("title", "", "task title (alternative to positional argument)")
 	createCmd.Flags().String("status", "", "task status (default from config)")
+	createCmd.Flags().String("start-in", "", "create already started in STATUS (must be past the initial status)")
+	createCmd.MarkFlagsMutuallyExclusive("status", "start-in")
 	createCmd.Flags().String("priority", "", "task priority (default from config)")
 	createCmd.Flags().String("assignee", "", "task assignee")
 	createCmd.Flags().StringSlice("tags", nil, "comma-separated tags")
@@ -46,25 +68,73 @@ func init() {
 		}
 		return pflag.NormalizedName(name)
 	})
+	createCmd.Flags().StringArray("link", nil, "link as URL or REL=URL, e.g. pr=https://... (repeatable)")
 	createCmd.Flags().String("due", "", "due date (YYYY-MM-DD)")
-	createCmd.Flags().String("estimate", "", "time estimate (e.g. 4h, 2d)")
+	createCmd.Flags().String("estimate", "", "time estimate (e.g. 4h, 2d, 1w)")
 	createCmd.Flags().Int("parent", 0, "parent task ID")
 	createCmd.Flags().IntSlice("depends-on", nil, "dependency task IDs (comma-separated)")
 	createCmd.Flags().String("body", "", "task body/description (markdown)")
+	createCmd.Flags().String("body-file", "", "read the body from a file (\"-\" for stdin)")
+	createCmd.MarkFlagsMutuallyExclusive("body", "body-file")
+	createCmd.Flags().Bool("stdin-body", false, "read the body verbatim from stdin")
 	createCmd.Flags().String("class", "", "class of service (expedite, fixed-date, standard, intangible)")
+	createCmd.Flags().Bool("stdin", false, "read tasks as JSON lines from stdin")
+	createCmd.MarkFlagsMutuallyExclusive("stdin", "body-file")
+	createCmd.Flags().Bool("edit", false, "open $EDITOR to write the task before saving")
+	createCmd.Flags().Int("from", 0, "copy fields from an existing task ID")
+	createCmd.MarkFlagsMutuallyExclusive("stdin", "from")
+	createCmd.Flags().Int("id", 0, "create the task with this ID instead of the next free one")
+	createCmd.MarkFlagsMutuallyExclusive("stdin", "id")
+	_ = createCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	_ = createCmd.RegisterFlagCompletionFunc("start-in", completeStatuses)
+	_ = createCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = createCmd.RegisterFlagCompletionFunc("class", completeClasses)
+	_ = createCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
 	rootCmd.AddCommand(createCmd)
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
-	// Acquire an exclusive lock to prevent concurrent creates from
-	// reading the same next_id and generating duplicate task IDs.
+	stdin, _ := cmd.Flags().GetBool("stdin")
+	edit, _ := cmd.Flags().GetBool("edit")
+	if stdin && edit {
+		return clierr.New(clierr.InvalidInput, "--stdin and --edit cannot be used together")
+	}
+
+	if err := resolveStdinBody(cmd, "body", "body-file", "stdin", "edit"); err != nil {
+		return err
+	}
+	if err := resolveBodyFileFlags(cmd, map[string]string{"body-file": "body"}); err != nil {
+		return err
+	}
+
 	dir, err := resolveDir()
 	if err != nil {
 		return err
 	}
-	unlock, err := filelock.Lock(filepath.Join(dir, ".lock"))
+
+	// Run the editor and read stdin before taking the lock so other creates
+	// are not blocked while the user types or a producer writes.
+	var draft *task.Task
+	if edit {
+		if draft, err = createDraftInEditor(cmd, args, dir); err != nil {
+			return err
+		}
+	}
+	var lines []stdinLine
+	if stdin {
+		if len(args) > 0 || cmd.Flags().Changed("title") {
+			return clierr.New(clierr.InvalidInput, "--stdin cannot be combined with a title argument or --title")
+		}
+		if lines, err = readStdinLines(os.Stdin); err != nil {
+			return err
+		}
+	}
+
+	// Acquire an exclusive lock to prevent concurrent creates from
+	// reading the same next_id and generating duplicate task IDs.
+	unlock, err := board.Lock(dir)
 	if err != nil {
-		return fmt.Errorf("acquiring lock: %w", err)
+		return err
 	}
 	defer unlock() //nolint:errcheck // best-effort unlock on exit
 
@@ -73,14 +143,80 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	title, err := resolveCreateTitle(cmd, args)
+	if stdin {
+		return createFromStdin(cfg, lines)
+	}
+
+	t := draft
+	if t == nil {
+		var title string
+		// With --from the title defaults to a copy of the source's.
+		if !cmd.Flags().Changed("from") || len(args) > 0 || cmd.Flags().Changed("title") {
+			if title, err = resolveCreateTitle(cmd, args); err != nil {
+				return err
+			}
+		}
+		if t, err = newTaskFromFlags(cmd, cfg, title); err != nil {
+			return err
+		}
+	}
+	t.ID = cfg.NextID
+	if cmd.Flags().Changed("id") {
+		id, _ := cmd.Flags().GetInt("id")
+		if err := checkIDFree(cfg, id); err != nil {
+			return err
+		}
+		t.ID = id
+	}
+
+	// Validate dependency references.
+	if err := validateDeps(cfg, t); err != nil {
+		return err
+	}
+
+	if err := enforceCreateWIP(cfg, t); err != nil {
+		return err
+	}
+
+	path, err := writeNewTask(cfg, t)
 	if err != nil {
 		return err
 	}
-	now := time.Now()
 
+	// Advance next_id past the new task and save config.
+	cfg.NextID = max(cfg.NextID, t.ID+1)
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	logActivity(cfg, "create", t.ID, t.Title)
+
+	return outputCreateResult(t, path)
+}
+
+// checkIDFree returns an error unless id is a valid task ID that no task file
+// uses yet. The caller must hold the board lock.
+func checkIDFree(cfg *config.Config, id int) error {
+	if id < 1 {
+		return task.ValidateTaskID(strconv.Itoa(id))
+	}
+	path, err := task.FindByID(cfg.TasksPath(), id)
+	if err == nil {
+		return task.ValidateIDExists(id, path)
+	}
+	var cliErr *clierr.Error
+	if errors.As(err, &cliErr) && cliErr.Code == clierr.TaskNotFound {
+		return nil
+	}
+	return err
+}
+
+// newTaskFromFlags builds a new task with config defaults, or the --from
+// task's fields, and the create flags applied. An empty title keeps the copied
+// one. The ID is assigned by the caller.
+func newTaskFromFlags(cmd *cobra.Command, cfg *config.Config, title string) (*task.Task, error) {
+	now := time.Now()
 	t := &task.Task{
-		ID:       cfg.NextID,
 		Title:    title,
 		Status:   cfg.Defaults.Status,
 		Priority: cfg.Defaults.Priority,
@@ -88,46 +224,83 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		Created:  now,
 		Updated:  now,
 	}
-
+	if cmd.Flags().Changed("from") {
+		from, _ := cmd.Flags().GetInt("from")
+		src, err := readTaskByID(cfg, from)
+		if err != nil {
+			return nil, err
+		}
+		copyTaskFields(t, src)
+		if title != "" {
+			t.Title = title
+		}
+	}
 	if err := applyCreateFlags(cmd, t, cfg); err != nil {
-		return err
+		return nil, err
 	}
+	return t, nil
+}
 
-	// Validate dependency references.
-	if err := validateDeps(cfg, t); err != nil {
-		return err
+// copyTaskFields copies the fields a new task inherits from src: title (with
+// a " (copy)" suffix), body, tags, priority, class and assignee.
+func copyTaskFields(t, src *task.Task) {
+	t.Title = src.Title + " (copy)"
+	t.Body = src.Body
+	t.Tags = slices.Clone(src.Tags)
+	t.Priority = src.Priority
+	t.Class = src.Class
+	t.Assignee = src.Assignee
+}
+
+// createDraftInEditor builds the new task from flags and lets the user edit it
+// in $EDITOR. The title may be left for the editor. The draft shows the next
+// free ID, which is re-assigned under the lock.
+func createDraftInEditor(cmd *cobra.Command, args []string, dir string) (*task.Task, error) {
+	cfg, err := config.Load(dir)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check WIP limit for the target status (class-aware).
-	if t.Class != "" && len(cfg.Classes) > 0 {
-		if err := enforceWIPLimitForClass(cfg, t, "", t.Status); err != nil {
-			return err
-		}
-	} else {
-		if err := enforceWIPLimit(cfg, "", t.Status); err != nil {
-			return err
+	var title string
+	if len(args) > 0 || cmd.Flags().Changed("title") {
+		if title, err = resolveCreateTitle(cmd, args); err != nil {
+			return nil, err
 		}
 	}
 
-	// Generate filename and write.
-	slug := task.GenerateSlug(title)
-	filename := task.GenerateFilename(t.ID, slug)
-	path := filepath.Join(cfg.TasksPath(), filename)
-	t.File = path
-
-	if err := task.Write(path, t); err != nil {
-		return fmt.Errorf("writing task: %w", err)
+	t, err := newTaskFromFlags(cmd, cfg, title)
+	if err != nil {
+		return nil, err
 	}
+	t.ID = cfg.NextID
+	return editInEditor(cfg, t)
+}
 
-	// Increment next_id and save config.
-	cfg.NextID++
-	if err := cfg.Save(); err != nil {
-		return fmt.Errorf("saving config: %w", err)
-	}
+// enforceCreateWIP checks the WIP limit for a new task's status (class- and
+// priority-aware).
+func enforceCreateWIP(cfg *config.Config, t *task.Task) error {
+	return board.EnforceWIP(cfg, t, "", t.Status)
+}
 
-	logActivity(cfg, "create", t.ID, t.Title)
+// writeNewTask generates the filename for a new task and writes it. A task
+// created past the initial status gets Started (and Completed in a terminal
+// status) as if it had been moved there.
+func writeNewTask(cfg *config.Config, t *task.Task) (string, error) {
+	if err := checkBodySize(cfg, t); err != nil {
+		return "", err
+	}
+	if err := checkClassPolicy(cfg, t); err != nil {
+		return "", err
+	}
+	task.UpdateTimestamps(t, "", t.Status, cfg)
+	recordTransition(cfg, t, "", t.Status)
+	path := filepath.Join(cfg.TasksPath(), task.FilenameFor(cfg, t))
+	t.File = path
 
-	return outputCreateResult(t, path)
+	if err := task.Write(path, t); err != nil {
+		return "", fmt.Errorf("writing task: %w", err)
+	}
+	return path, nil
 }
 
 func outputCreateResult(t *task.Task, path string) error {
@@ -168,13 +341,26 @@ func resolveCreateTitle(cmd *cobra.Command, args []string) (string, error) {
 
 func applyCreateFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) error {
 	if v, _ := cmd.Flags().GetString("status"); v != "" {
-		if err := task.ValidateStatus(v, cfg.StatusNames()); err != nil {
+		status, err := task.ResolveStatus(cfg, v)
+		if err != nil {
+			return err
+		}
+		t.Status = status
+	}
+	if v, _ := cmd.Flags().GetString("start-in"); v != "" {
+		status, err := task.ResolveStatus(cfg, v)
+		if err != nil {
 			return err
 		}
-		t.Status = v
+		if cfg.IsInitialStatus(status) {
+			return clierr.Newf(clierr.InvalidInput,
+				"--start-in needs a status past the initial statuses, got %q", status).
+				WithDetails(map[string]any{"status": status})
+		}
+		t.Status = status
 	}
 	if v, _ := cmd.Flags().GetString("priority"); v != "" {
-		if err := task.ValidatePriority(v, cfg.Priorities); err != nil {
+		if err := task.ValidatePriority(v, cfg.PriorityNames()); err != nil {
 			return err
 		}
 		t.Priority = v
@@ -185,6 +371,13 @@ func applyCreateFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) erro
 	if v, _ := cmd.Flags().GetStringSlice("tags"); len(v) > 0 {
 		t.Tags = v
 	}
+	if v, _ := cmd.Flags().GetStringArray("link"); len(v) > 0 {
+		links, err := task.ParseLinks(v)
+		if err != nil {
+			return err
+		}
+		t.Links = task.AddLinks(nil, links...)
+	}
 	if v, _ := cmd.Flags().GetString("due"); v != "" {
 		d, err := date.Parse(v)
 		if err != nil {
@@ -193,6 +386,9 @@ func applyCreateFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config) erro
 		t.Due = &d
 	}
 	if v, _ := cmd.Flags().GetString("estimate"); v != "" {
+		if _, err := task.ParseEstimate(v); err != nil {
+			return task.ValidateEstimate(v, err)
+		}
 		t.Estimate = v
 	}
 	if cmd.Flags().Changed("parent") {
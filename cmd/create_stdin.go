@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// maxStdinLine bounds a single JSON line read by create --stdin.
+const maxStdinLine = 1 << 20
+
+// stdinTask is one JSON line accepted by create --stdin.
+type stdinTask struct {
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	Priority  string   `json:"priority"`
+	Assignee  string   `json:"assignee"`
+	Tags      []string `json:"tags"`
+	Due       string   `json:"due"`
+	Estimate  string   `json:"estimate"`
+	Class     string   `json:"class"`
+	Body      string   `json:"body"`
+	Parent    *int     `json:"parent"`
+	DependsOn []int    `json:"depends_on"`
+}
+
+// stdinLineResult reports the outcome of one input line when some lines fail.
+type stdinLineResult struct {
+	Line  int    `json:"line"`
+	OK    bool   `json:"ok"`
+	ID    int    `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+	Code  string `json:"code,omitempty"`
+}
+
+// stdinLine is one non-blank input line of create --stdin.
+type stdinLine struct {
+	no   int
+	data []byte
+}
+
+// readStdinLines reads every non-blank line from r. It runs before the board
+// lock is taken, so a slow producer does not block other writers.
+func readStdinLines(r io.Reader) ([]stdinLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxStdinLine)
+
+	var lines []stdinLine
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			lines = append(lines, stdinLine{no: lineNo, data: bytes.Clone(line)})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "reading stdin: line %d: %v", lineNo+1, err)
+	}
+	if len(lines) == 0 {
+		return nil, clierr.New(clierr.InvalidInput, "no tasks on stdin")
+	}
+	return lines, nil
+}
+
+// createFromStdin creates one task per JSON line. The caller must hold the
+// board lock. IDs are allocated sequentially and next_id is saved once.
+func createFromStdin(cfg *config.Config, lines []stdinLine) error {
+	var (
+		created []*task.Task
+		results []stdinLineResult
+		// byIndex maps each input object to the ID it was created with, or 0
+		// if it failed, for negative references.
+		byIndex []int
+		failed  bool
+	)
+	startID := cfg.NextID
+	for _, line := range lines {
+		t, err := createStdinLine(cfg, line.data, byIndex)
+		if err != nil {
+			failed = true
+			byIndex = append(byIndex, 0)
+			results = append(results, stdinLineFailure(line.no, err))
+			continue
+		}
+		cfg.NextID++
+		byIndex = append(byIndex, t.ID)
+		created = append(created, t)
+		results = append(results, stdinLineResult{Line: line.no, OK: true, ID: t.ID})
+		logActivity(cfg, "create", t.ID, t.Title)
+	}
+
+	if cfg.NextID != startID {
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("saving config: %w", err)
+		}
+	}
+
+	return outputStdinResult(created, results, failed)
+}
+
+// createStdinLine parses, validates and writes a single task. byIndex holds
+// the IDs created for previous lines.
+func createStdinLine(cfg *config.Config, line []byte, byIndex []int) (*task.Task, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.DisallowUnknownFields()
+	var in stdinTask
+	if err := dec.Decode(&in); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid JSON: %v", err)
+	}
+
+	t, err := buildStdinTask(cfg, in, byIndex)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDeps(cfg, t); err != nil {
+		return nil, err
+	}
+	if err := enforceCreateWIP(cfg, t); err != nil {
+		return nil, err
+	}
+	if _, err := writeNewTask(cfg, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// buildStdinTask converts a JSON line into a task with config defaults,
+// resolving negative parent and dependency references.
+func buildStdinTask(cfg *config.Config, in stdinTask, byIndex []int) (*task.Task, error) {
+	if in.Title == "" {
+		return nil, clierr.New(clierr.InvalidInput, "title is required")
+	}
+
+	now := time.Now()
+	t := &task.Task{
+		ID:       cfg.NextID,
+		Title:    in.Title,
+		Status:   cfg.Defaults.Status,
+		Priority: cfg.Defaults.Priority,
+		Class:    cfg.Defaults.Class,
+		Created:  now,
+		Updated:  now,
+		Assignee: in.Assignee,
+		Tags:     in.Tags,
+		Body:     in.Body,
+	}
+
+	if in.Status != "" {
+		status, err := task.ResolveStatus(cfg, in.Status)
+		if err != nil {
+			return nil, err
+		}
+		t.Status = status
+	}
+	if in.Priority != "" {
+		if err := task.ValidatePriority(in.Priority, cfg.PriorityNames()); err != nil {
+			return nil, err
+		}
+		t.Priority = in.Priority
+	}
+	if in.Class != "" {
+		if err := task.ValidateClass(in.Class, cfg.ClassNames()); err != nil {
+			return nil, err
+		}
+		t.Class = in.Class
+	}
+	if in.Due != "" {
+		d, err := date.Parse(in.Due)
+		if err != nil {
+			return nil, task.FormatDueDate(in.Due, err)
+		}
+		t.Due = &d
+	}
+	if in.Estimate != "" {
+		if _, err := task.ParseEstimate(in.Estimate); err != nil {
+			return nil, task.ValidateEstimate(in.Estimate, err)
+		}
+		t.Estimate = in.Estimate
+	}
+
+	if in.Parent != nil {
+		id, err := resolveStdinRef(*in.Parent, byIndex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent: %w", err)
+		}
+		t.Parent = &id
+	}
+	for _, ref := range in.DependsOn {
+		id, err := resolveStdinRef(ref, byIndex)
+		if err != nil {
+			return nil, err
+		}
+		t.DependsOn = append(t.DependsOn, id)
+	}
+	return t, nil
+}
+
+// resolveStdinRef maps a negative reference to the ID created for an earlier
+// line. Positive references are returned unchanged.
+func resolveStdinRef(ref int, byIndex []int) (int, error) {
+	if ref >= 0 {
+		return ref, nil
+	}
+	idx := len(byIndex) + ref
+	if idx < 0 {
+		return 0, clierr.Newf(clierr.InvalidInput, "reference %d points before the first line", ref)
+	}
+	if byIndex[idx] == 0 {
+		return 0, clierr.Newf(clierr.DependencyNotFound, "reference %d points to a line that failed", ref)
+	}
+	return byIndex[idx], nil
+}
+
+func stdinLineFailure(line int, err error) stdinLineResult {
+	res := stdinLineResult{Line: line, Error: err.Error()}
+	var cliErr *clierr.Error
+	if errors.As(err, &cliErr) {
+		res.Code = cliErr.Code
+	}
+	return res
+}
+
+// outputStdinResult prints the created tasks, or per-line results when any
+// line failed. Returns a SilentError with exit code 1 on partial failure.
+func outputStdinResult(created []*task.Task, results []stdinLineResult, failed bool) error {
+	if outputFormat() == output.FormatJSON {
+		var err error
+		if failed {
+			err = output.JSON(os.Stdout, results)
+		} else {
+			err = output.JSON(os.Stdout, created)
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, r := range results {
+			if !r.OK {
+				fmt.Fprintf(os.Stderr, "Error: line %d: %s\n", r.Line, r.Error)
+			}
+		}
+		for _, t := range created {
+			output.Messagef(os.Stdout, "Created task #%d: %s", t.ID, t.Title)
+		}
+		output.Messagef(os.Stdout, "Created %d/%d tasks", len(created), len(results))
+	}
+
+	if failed {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestCreateStartInCountsLeadAndCycleTime(t *testing.T) {
+	root, cfg := newTestBoard(t, nil)
+
+	tests := []struct {
+		args          []string
+		wantStarted   bool
+		wantCompleted bool
+	}{
+		{[]string{"create", "queued"}, false, false},
+		{[]string{"create", "started", "--start-in", "in-progress"}, true, false},
+		{[]string{"create", "shipped", "--start-in", "done"}, true, true},
+	}
+	for _, tt := range tests {
+		if out, err := runCLI(t, root, tt.args...); err != nil {
+			t.Fatalf("%s: %v\n%s", strings.Join(tt.args, " "), err, out)
+		}
+	}
+
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != len(tests) {
+		t.Fatalf("got %d tasks, want %d", len(tasks), len(tests))
+	}
+	for i, tk := range tasks {
+		tt := tests[i]
+		if got := tk.Started != nil; got != tt.wantStarted {
+			t.Errorf("%s: Started set = %v, want %v", tk.Title, got, tt.wantStarted)
+		}
+		if got := tk.Completed != nil; got != tt.wantCompleted {
+			t.Errorf("%s: Completed set = %v, want %v", tk.Title, got, tt.wantCompleted)
+		}
+	}
+
+	// Moving the started task to done completes it with its start time intact.
+	if out, err := runCLI(t, root, "move", "2", "done"); err != nil {
+		t.Fatalf("move: %v\n%s", err, out)
+	}
+	out, err := runCLI(t, root, "stats", "--json")
+	if err != nil {
+		t.Fatalf("stats: %v\n%s", err, out)
+	}
+	var stats struct {
+		Completed      int `json:"completed"`
+		MissingStarted int `json:"missing_started"`
+		LeadTime       struct {
+			Count int `json:"count"`
+		} `json:"lead_time"`
+		CycleTime struct {
+			Count int `json:"count"`
+		} `json:"cycle_time"`
+	}
+	if err := json.Unmarshal(out, &stats); err != nil {
+		t.Fatalf("parsing stats: %v\n%s", err, out)
+	}
+	if stats.Completed != 2 || stats.LeadTime.Count != 2 || stats.CycleTime.Count != 2 || stats.MissingStarted != 0 {
+		t.Errorf("stats = %+v, want 2 completed, all with lead and cycle time", stats)
+	}
+}
+
+func TestCreateStartInRejectsInitialStatus(t *testing.T) {
+	root, _ := newTestBoard(t, nil)
+
+	out, err := runCLI(t, root, "create", "early", "--start-in", "backlog")
+	if err == nil || !strings.Contains(string(out), "past the initial statuses") {
+		t.Errorf("create --start-in backlog = %v\n%s; want an initial-status error", err, out)
+	}
+}
+
+func TestCreateStdinReadErrorWritesNothing(t *testing.T) {
+	root, cfg := newTestBoard(t, nil)
+
+	input := `{"title":"ok"}` + "\n" + strings.Repeat("x", maxStdinLine+1) + "\n"
+	out, err := runCLIInput(t, root, input, "create", "--stdin")
+	if err == nil || !strings.Contains(string(out), "token too long") {
+		t.Fatalf("create --stdin = %v\n%s; want a read error", err, out)
+	}
+	if tasks, err := task.ReadAll(cfg.TasksPath()); err != nil || len(tasks) != 0 {
+		t.Fatalf("tasks after a read error = %d (%v), want none", len(tasks), err)
+	}
+
+	if out, err := runCLIInput(t, root, `{"title":"one"}`+"\n"+`{"title":"two"}`+"\n", "create", "--stdin"); err != nil {
+		t.Fatalf("create --stdin: %v\n%s", err, out)
+	}
+	if out, err := runCLI(t, root, "create", "three"); err != nil {
+		t.Fatalf("create: %v\n%s", err, out)
+	}
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil || len(tasks) != 3 {
+		t.Fatalf("got %d tasks (%v), want 3", len(tasks), err)
+	}
+	for i, tk := range tasks {
+		if tk.ID != i+1 {
+			t.Errorf("task %q has ID %d, want %d", tk.Title, tk.ID, i+1)
+		}
+	}
+}
+
+func TestCreateBodyFileFollowsBodyLimit(t *testing.T) {
+	const size = 2 << 20 // above the 1 MiB default
+	tests := []struct {
+		name    string
+		limit   int
+		wantErr bool
+	}{
+		{"raised limit", 4 << 20, false},
+		{"default limit", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, _ := newTestBoard(t, func(c *config.Config) { c.Limits.MaxBodyBytes = tt.limit })
+			path := filepath.Join(t.TempDir(), "body.md")
+			if err := os.WriteFile(path, []byte(strings.Repeat("x", size)), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := runCLI(t, root, "create", "big", "--body-file", path)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(string(out), "byte limit") {
+					t.Errorf("create = %v\n%.200s; want a body limit error", err, out)
+				}
+			} else if err != nil {
+				t.Errorf("create: %v\n%.200s", err, out)
+			}
+		})
+	}
+}
@@ -22,13 +22,16 @@ var deleteCmd = &cobra.Command{
 	Aliases: []string{"rm"},
 	Short:   "Delete a task",
 	Long: `Soft-deletes a task by moving it to archived status. Prompts for confirmation in interactive mode.
-Multiple IDs can be provided as a comma-separated list (requires --yes).`,
+Multiple IDs can be provided as a comma-separated list (requires --yes).
+
+Use --hard to permanently remove the task file instead of archiving it.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runDelete,
 }
 
 func init() {
 	deleteCmd.Flags().BoolP("yes", "y", false, "skip confirmation prompt")
+	deleteCmd.Flags().Bool("hard", false, "permanently remove the task file instead of archiving")
 	rootCmd.AddCommand(deleteCmd)
 }
 
@@ -44,6 +47,7 @@ func runDelete(cmd *cobra.Command, args []string) error {
 	}
 
 	yes, _ := cmd.Flags().GetBool("yes")
+	hard, _ := cmd.Flags().GetBool("hard")
 
 	// Batch mode requires --yes.
 	if len(ids) > 1 && !yes {
@@ -53,17 +57,17 @@ func runDelete(cmd *cobra.Command, args []string) error {
 
 	// Single ID: preserve exact current behavior.
 	if len(ids) == 1 {
-		return deleteSingleTask(cfg, ids[0], yes)
+		return deleteSingleTask(cfg, ids[0], yes, hard)
 	}
 
 	// Batch mode (yes is guaranteed true here).
 	return runBatch(ids, func(id int) error {
-		return executeDelete(cfg, id)
+		return executeDelete(cfg, id, hard)
 	})
 }
 
 // deleteSingleTask handles a single task delete with confirmation and output.
-func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
+func deleteSingleTask(cfg *config.Config, id int, yes, hard bool) error {
 	path, err := task.FindByID(cfg.TasksPath(), id)
 	if err != nil {
 		return err
@@ -88,7 +92,11 @@ func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
 			return clierr.New(clierr.ConfirmationReq,
 				"cannot prompt for confirmation (not a terminal); use --yes")
 		}
-		fmt.Fprintf(os.Stderr, "Delete task #%d %q? [y/N] ", t.ID, t.Title)
+		verb := "Delete"
+		if hard {
+			verb = "Permanently delete"
+		}
+		fmt.Fprintf(os.Stderr, "%s task #%d %q? [y/N] ", verb, t.ID, t.Title)
 		reader := bufio.NewReader(os.Stdin)
 		answer, _ := reader.ReadString('\n')
 		answer = strings.TrimSpace(strings.ToLower(answer))
@@ -98,42 +106,78 @@ func deleteSingleTask(cfg *config.Config, id int, yes bool) error {
 		}
 	}
 
-	if err := softDeleteAndLog(cfg, path, t); err != nil {
+	// Re-read under the lock: the task may have changed while prompting.
+	if t, err = deleteLocked(cfg, id, hard, false); err != nil {
 		return err
 	}
 
+	status := "deleted"
+	if hard {
+		status = "hard-deleted"
+	}
 	if outputFormat() == output.FormatJSON {
 		return output.JSON(os.Stdout, map[string]interface{}{
-			"status": "deleted",
+			"status": status,
 			"id":     t.ID,
 			"title":  t.Title,
 		})
 	}
 
+	if hard {
+		output.Messagef(os.Stdout, "Permanently deleted task #%d: %s", t.ID, t.Title)
+		return nil
+	}
 	output.Messagef(os.Stdout, "Deleted task #%d: %s", t.ID, t.Title)
 	return nil
 }
 
-// executeDelete performs the core delete: find, read, claim check, warn dependents, remove, log.
-func executeDelete(cfg *config.Config, id int) error {
-	path, err := task.FindByID(cfg.TasksPath(), id)
-	if err != nil {
-		return err
-	}
+// executeDelete performs the core delete under the board lock: find, read,
+// claim check, warn dependents, remove, log.
+func executeDelete(cfg *config.Config, id int, hard bool) error {
+	_, err := deleteLocked(cfg, id, hard, true)
+	return err
+}
 
-	t, err := task.Read(path)
-	if err != nil {
-		return err
-	}
+// deleteLocked reads task id under the board lock, checks its claim and
+// deletes it. warn prints the tasks that depend on it first.
+func deleteLocked(cfg *config.Config, id int, hard, warn bool) (*task.Task, error) {
+	var t *task.Task
+	err := withBoardLock(cfg, func() error {
+		path, err := task.FindByID(cfg.TasksPath(), id)
+		if err != nil {
+			return err
+		}
+		if t, err = task.Read(path); err != nil {
+			return err
+		}
+		if err = checkClaim(t, "", cfg.ClaimTimeoutDuration()); err != nil {
+			return err
+		}
+		if warn {
+			warnDependents(cfg.TasksPath(), t.ID)
+		}
+		return deleteAndLog(cfg, path, t, hard)
+	})
+	return t, err
+}
 
-	if err = checkClaim(t, "", cfg.ClaimTimeoutDuration()); err != nil {
-		return err
+// deleteAndLog archives the task, or removes its file when hard is set.
+func deleteAndLog(cfg *config.Config, path string, t *task.Task, hard bool) error {
+	if hard {
+		return hardDeleteAndLog(cfg, path, t)
 	}
-
-	warnDependents(cfg.TasksPath(), t.ID)
 	return softDeleteAndLog(cfg, path, t)
 }
 
+// hardDeleteAndLog removes the task file and logs the hard-delete action.
+func hardDeleteAndLog(cfg *config.Config, path string, t *task.Task) error {
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("removing task file: %w", err)
+	}
+	logActivity(cfg, "hard-delete", t.ID, t.Title)
+	return nil
+}
+
 // softDeleteAndLog archives the task and logs the delete action.
 func softDeleteAndLog(cfg *config.Config, path string, t *task.Task) error {
 	if t.Status == config.ArchivedStatus {
@@ -143,6 +187,7 @@ func softDeleteAndLog(cfg *config.Config, path string, t *task.Task) error {
 	oldStatus := t.Status
 	t.Status = config.ArchivedStatus
 	task.UpdateTimestamps(t, oldStatus, t.Status, cfg)
+	recordTransition(cfg, t, oldStatus, t.Status)
 	t.Updated = time.Now()
 
 	if err := task.Write(path, t); err != nil {
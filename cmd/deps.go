@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var depsCmd = &cobra.Command{
+	Use:   "deps ID",
+	Short: "Show a task's dependency tree",
+	Long: `Prints the tasks that a task depends on, recursively, with their status.
+Completed dependencies are marked with ✓. Use --reverse to show the tasks
+that depend on it instead. Cycles are shown once with a "(cycle)" marker.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDeps,
+}
+
+func init() {
+	depsCmd.Flags().Bool("reverse", false, "show tasks that depend on this task")
+	depsCmd.Flags().Int("depth", 0, "maximum depth to expand (0 for unlimited)")
+	rootCmd.AddCommand(depsCmd)
+}
+
+func runDeps(cmd *cobra.Command, args []string) error {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		return task.ValidateTaskID(args[0])
+	}
+	reverse, _ := cmd.Flags().GetBool("reverse")
+	depth, _ := cmd.Flags().GetInt("depth")
+	if depth < 0 {
+		return clierr.New(clierr.InvalidInput, "--depth must be zero or positive")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if _, err = task.FindByID(cfg.TasksPath(), id); err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	root := board.DependencyTree(cfg, tasks, id, reverse, depth)
+	if root == nil {
+		// The file exists but failed to parse; the warning was printed above.
+		return clierr.Newf(clierr.TaskNotFound, "task #%d could not be read", id)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, root)
+	}
+	output.DepTree(os.Stdout, root)
+	return nil
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff ID [ID2 | --against FILE]",
+	Short: "Compare two tasks field by field",
+	Long: `Prints the fields that differ between two tasks and a unified diff of
+their bodies. With --against, the task is compared to a task file such as a
+template. IDs, timestamps and time logs are not compared.`,
+	Args:              cobra.RangeArgs(1, 2), //nolint:mnd // one or two task IDs
+	ValidArgsFunction: cobra.NoFileCompletions,
+	RunE:              runDiff,
+}
+
+func init() {
+	diffCmd.Flags().String("against", "", "compare against a task file instead of a second task")
+	rootCmd.AddCommand(diffCmd)
+}
+
+// diffResult is the JSON output of diff.
+type diffResult struct {
+	A        string             `json:"a"`
+	B        string             `json:"b"`
+	Changes  []task.FieldChange `json:"changes"`
+	BodyDiff []string           `json:"body_diff,omitempty"`
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	against, _ := cmd.Flags().GetString("against")
+	if (against == "") == (len(args) == 1) {
+		return clierr.New(clierr.InvalidInput, "provide a second task ID or --against FILE")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	idA, err := strconv.Atoi(args[0])
+	if err != nil {
+		return task.ValidateTaskID(args[0])
+	}
+	a, err := readTaskByID(cfg, idA)
+	if err != nil {
+		return err
+	}
+
+	var b *task.Task
+	labelB := against
+	if against != "" {
+		if b, err = task.Read(against); err != nil {
+			return clierr.Newf(clierr.InvalidInput, "reading %s: %v", against, err)
+		}
+	} else {
+		idB, idErr := strconv.Atoi(args[1])
+		if idErr != nil {
+			return task.ValidateTaskID(args[1])
+		}
+		if b, err = readTaskByID(cfg, idB); err != nil {
+			return err
+		}
+		labelB = "#" + strconv.Itoa(idB)
+	}
+
+	res := diffResult{
+		A:        "#" + strconv.Itoa(idA),
+		B:        labelB,
+		Changes:  task.Diff(a, b),
+		BodyDiff: task.DiffLines(a.Body, b.Body),
+	}
+	if res.Changes == nil {
+		res.Changes = []task.FieldChange{}
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, res)
+	}
+	output.DiffTable(os.Stdout, res.A, res.B, res.Changes, res.BodyDiff)
+	return nil
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check and repair board integrity",
+	Long: `Checks the board for problems: an invalid config, next_id not above the
+highest task ID, duplicate IDs, filenames that disagree with their
+frontmatter ID, unknown statuses/priorities/classes, parents or dependencies
+that point at missing tasks, unparseable task files, and stale terminal
+session files.
+
+With --fix, the safe problems are repaired under the board lock: next_id is
+bumped, mismatched files are renamed, dangling references are cleared and
+stale session files are removed. Exits 1 if any problem remains unfixed.`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+func init() {
+	doctorCmd.Flags().Bool("fix", false, "repair problems that can be fixed safely")
+	rootCmd.AddCommand(doctorCmd)
+}
+
+func runDoctor(cmd *cobra.Command, _ []string) error {
+	fix, _ := cmd.Flags().GetBool("fix")
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+
+	if fix {
+		unlock, lockErr := board.Lock(dir)
+		if lockErr != nil {
+			return lockErr
+		}
+		defer unlock() //nolint:errcheck // best-effort unlock on exit
+	}
+
+	// Load the raw file so an invalid config is reported rather than fatal.
+	cfg, err := config.LoadRaw(dir)
+	if err != nil {
+		return err
+	}
+	cfgErr := config.Migrate(cfg)
+	if cfgErr == nil {
+		cfgErr = cfg.Validate()
+	}
+	if cfgErr != nil && !errors.Is(cfgErr, config.ErrInvalid) {
+		return cfgErr
+	}
+
+	problems, err := board.Diagnose(cfg, cfgErr)
+	if err != nil {
+		return err
+	}
+	if fix && cfgErr == nil {
+		board.Repair(problems)
+	}
+
+	if err := outputDoctorReport(problems, fix); err != nil {
+		return err
+	}
+	for _, p := range problems {
+		if !p.Fixed {
+			return &clierr.SilentError{Code: 1}
+		}
+	}
+	return nil
+}
+
+func outputDoctorReport(problems []board.Problem, fix bool) error {
+	if outputFormat() == output.FormatJSON {
+		if problems == nil {
+			problems = []board.Problem{}
+		}
+		return output.JSON(os.Stdout, problems)
+	}
+
+	if len(problems) == 0 {
+		output.Messagef(os.Stdout, "No problems found")
+		return nil
+	}
+	output.DoctorTable(os.Stdout, problems)
+	if !fix {
+		fixable := 0
+		for _, p := range problems {
+			if p.Fixable {
+				fixable++
+			}
+		}
+		if fixable > 0 {
+			fmt.Fprintf(os.Stderr, "%d of %d problems can be repaired with --fix\n", fixable, len(problems))
+		}
+	}
+	return nil
+}
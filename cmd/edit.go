@@ -1,14 +1,19 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
@@ -17,11 +22,26 @@ import (
 )
 
 var editCmd = &cobra.Command{
-	Use:   "edit ID[,ID,...]",
+	Use:   "edit {ID[,ID,...] | --where FILTER}",
 	Short: "Edit a task",
 	Long: `Modifies fields of an existing task. Only specified fields are changed.
-Multiple IDs can be provided as a comma-separated list.`,
-	Args: cobra.ExactArgs(1),
+Multiple IDs can be provided as a comma-separated list, or pass "-" to read
+IDs separated by whitespace, commas or newlines from stdin.
+
+Use --where to edit every task matching a filter, e.g.
+"edit --where assignee=alice --priority high". The matched count is
+confirmed interactively unless --yes is given, and --max caps the matches.
+
+With --move-next-if-unblocked, an edit that moves a task to a terminal status
+also advances its dependents: each one still in the initial status whose
+dependencies are now all done moves to the next status. Dependents that are
+claimed by someone else, need a claim, or would exceed a WIP limit stay put.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("where") {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	RunE: runEdit,
 }
 
@@ -32,11 +52,17 @@ func init() {
 	editCmd.Flags().String("assignee", "", "new assignee")
 	editCmd.Flags().StringSlice("add-tag", nil, "add tags")
 	editCmd.Flags().StringSlice("remove-tag", nil, "remove tags")
+	editCmd.Flags().StringArray("add-link", nil, "add a link as URL or REL=URL, e.g. pr=https://... (repeatable)")
+	editCmd.Flags().StringArray("remove-link", nil, "remove links by URL or rel (repeatable)")
 	editCmd.Flags().String("due", "", "new due date (YYYY-MM-DD)")
 	editCmd.Flags().Bool("clear-due", false, "clear due date")
-	editCmd.Flags().String("estimate", "", "new time estimate")
+	editCmd.Flags().String("estimate", "", "new time estimate (e.g. 4h, 2d, 1w)")
 	editCmd.Flags().String("body", "", "new body text (replaces entire body)")
 	editCmd.Flags().StringP("append-body", "a", "", "append text to task body")
+	editCmd.Flags().String("body-file", "", "replace the body with a file's content (\"-\" for stdin)")
+	editCmd.Flags().String("append-body-file", "", "append a file's content to the body (\"-\" for stdin)")
+	editCmd.MarkFlagsMutuallyExclusive("body", "body-file", "append-body", "append-body-file")
+	editCmd.Flags().Bool("stdin-body", false, "replace the body with stdin, stored verbatim")
 	editCmd.Flags().BoolP("timestamp", "t", false, "prefix a timestamp line when appending")
 	editCmd.Flags().String("started", "", "set started date (YYYY-MM-DD)")
 	editCmd.Flags().Bool("clear-started", false, "clear started timestamp")
@@ -50,12 +76,31 @@ func init() {
 	editCmd.Flags().Bool("unblock", false, "clear blocked state")
 	editCmd.Flags().String("claim", "", "claim task for an agent")
 	editCmd.Flags().Bool("release", false, "release claim on task")
-	editCmd.Flags().String("class", "", "set class of service")
+	editCmd.Flags().String("class", "", "set class of service (empty string clears it)")
+	editCmd.Flags().Bool("edit", false, "open $EDITOR on the task after applying other flags")
+	editCmd.Flags().Bool("move-next-if-unblocked", false, "when done, advance dependents whose dependencies are all done")
+	addWhereFlags(editCmd)
+	editCmd.MarkFlagsMutuallyExclusive("where", "edit")
+	_ = editCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	_ = editCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = editCmd.RegisterFlagCompletionFunc("class", completeClasses)
+	_ = editCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
 	rootCmd.AddCommand(editCmd)
 }
 
 func runEdit(cmd *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	fromStdin := len(args) > 0 && args[0] == stdinIDsArg
+	if fromStdin {
+		if err := checkStdinIDsConflicts(cmd); err != nil {
+			return err
+		}
+	}
+
+	err := resolveStdinBody(cmd, "body", "append-body", "body-file", "append-body-file", "edit")
+	if err != nil {
+		return err
+	}
+	err = resolveBodyFileFlags(cmd, map[string]string{"body-file": "body", "append-body-file": "append-body"})
 	if err != nil {
 		return err
 	}
@@ -65,11 +110,38 @@ func runEdit(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if cmd.Flags().Changed("where") {
+		return runWhereBatch(cmd, cfg, "Edit", func(id int) error {
+			_, _, err := executeEdit(cfg, id, cmd)
+			return err
+		})
+	}
+
+	if fromStdin {
+		ids, err := readStdinIDs(os.Stdin)
+		if err != nil {
+			return err
+		}
+		return runBatch(ids, func(id int) error {
+			_, _, err := executeEdit(cfg, id, cmd)
+			return err
+		})
+	}
+
+	ids, err := parseIDs(args[0])
+	if err != nil {
+		return err
+	}
+
 	// Single ID: preserve exact current behavior.
 	if len(ids) == 1 {
 		return editSingleTask(cfg, ids[0], cmd)
 	}
 
+	if edit, _ := cmd.Flags().GetBool("edit"); edit {
+		return clierr.New(clierr.InvalidInput, "--edit works on a single task")
+	}
+
 	// Batch mode.
 	return runBatch(ids, func(id int) error {
 		_, _, err := executeEdit(cfg, id, cmd)
@@ -77,6 +149,23 @@ func runEdit(cmd *cobra.Command, args []string) error {
 	})
 }
 
+// checkStdinIDsConflicts rejects flags that also need stdin or a terminal
+// when IDs are piped in.
+func checkStdinIDsConflicts(cmd *cobra.Command) error {
+	if edit, _ := cmd.Flags().GetBool("edit"); edit {
+		return clierr.New(clierr.InvalidInput, "--edit cannot be used with IDs from stdin")
+	}
+	for _, name := range []string{"body-file", "append-body-file"} {
+		if v, _ := cmd.Flags().GetString(name); v == "-" {
+			return clierr.Newf(clierr.InvalidInput, "--%s - cannot be used with IDs from stdin", name)
+		}
+	}
+	if v, _ := cmd.Flags().GetBool("stdin-body"); v {
+		return clierr.New(clierr.InvalidInput, "--stdin-body cannot be used with IDs from stdin")
+	}
+	return nil
+}
+
 // editSingleTask handles a single task edit with full output.
 func editSingleTask(cfg *config.Config, id int, cmd *cobra.Command) error {
 	t, newPath, err := executeEdit(cfg, id, cmd)
@@ -93,9 +182,85 @@ func editSingleTask(cfg *config.Config, id int, cmd *cobra.Command) error {
 	return nil
 }
 
-// executeEdit performs the core edit: find, read, apply, validate, write, log.
+// executeEdit performs the core edit under the board lock: find, read, apply,
+// validate, write, log. With --edit the editor runs first, without the lock.
 // Returns the modified task and its new file path.
 func executeEdit(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task, string, error) {
+	var (
+		t       *task.Task
+		newPath string
+		draft   *editDraft
+	)
+	if edit, _ := cmd.Flags().GetBool("edit"); edit {
+		var err error
+		if draft, err = editDraftInEditor(cfg, id, cmd); err != nil {
+			return nil, "", err
+		}
+	}
+	err := withBoardLock(cfg, func() error {
+		var err error
+		t, newPath, err = editTask(cfg, id, cmd, draft)
+		return err
+	})
+	return t, newPath, err
+}
+
+// editDraft is a task edited in $EDITOR outside the lock, with the file
+// content it was based on.
+type editDraft struct {
+	task *task.Task
+	base []byte
+}
+
+// editDraftInEditor reads the task, applies the edit flags and lets the user
+// edit the result in $EDITOR. It runs without the board lock so other
+// commands are not blocked while the editor is open.
+func editDraftInEditor(cfg *config.Config, id int, cmd *cobra.Command) (*editDraft, error) {
+	path, err := task.FindByID(cfg.TasksPath(), id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // task path from trusted source
+	if err != nil {
+		return nil, fmt.Errorf("reading task file: %w", err)
+	}
+	t, err := task.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	t.File = path
+
+	claimant, release, err := validateEditClaim(cfg, t, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := applyEditChanges(cmd, t, cfg, claimant, release); err != nil {
+		return nil, err
+	}
+	if t, err = editInEditor(cfg, t); err != nil {
+		return nil, err
+	}
+	return &editDraft{task: t, base: data}, nil
+}
+
+// checkUnchanged returns a StatusConflict error if the task file at path no
+// longer matches the content the draft was based on.
+func (d *editDraft) checkUnchanged(path string, id int) error {
+	data, err := os.ReadFile(path) //nolint:gosec // task path from trusted source
+	if err != nil {
+		return fmt.Errorf("reading task file: %w", err)
+	}
+	if !bytes.Equal(data, d.base) {
+		return clierr.Newf(clierr.StatusConflict,
+			"task #%d was changed while it was open in the editor; no changes made", id).
+			WithDetails(map[string]any{"id": id})
+	}
+	return nil
+}
+
+// editTask is executeEdit without the lock. draft, if non-nil, is the
+// task as edited in $EDITOR; it is applied only if the file is unchanged.
+func editTask(cfg *config.Config, id int, cmd *cobra.Command, draft *editDraft) (*task.Task, string, error) {
 	path, err := task.FindByID(cfg.TasksPath(), id)
 	if err != nil {
 		return nil, "", err
@@ -111,39 +276,123 @@ func executeEdit(cfg *config.Config, id int, cmd *cobra.Command) (*task.Task, st
 		return nil, "", err
 	}
 
+	before := *t
 	oldTitle := t.Title
 	oldStatus := t.Status
-	wasBlocked := t.Blocked
-	wasClaimedBy := t.ClaimedBy
 	changed, err := applyEditChanges(cmd, t, cfg, claimant, release)
 	if err != nil {
 		return nil, "", err
 	}
 
+	if draft != nil {
+		if err := draft.checkUnchanged(path, id); err != nil {
+			return nil, "", err
+		}
+		t = draft.task
+		changed = true
+	}
+
 	if !changed {
 		return nil, "", clierr.New(clierr.NoChanges, "no changes specified")
 	}
 
-	if err = validateEditPost(cfg, t, oldStatus, claimant); err != nil {
+	if err = validateEditPost(cfg, t, &before, claimant); err != nil {
 		return nil, "", err
 	}
 
+	if t.Status != oldStatus {
+		started, completed := t.Started, t.Completed
+		task.UpdateTimestamps(t, oldStatus, t.Status, cfg)
+		// Explicit timestamp flags win over the ones the move would set.
+		if cmd.Flags().Changed("started") || cmd.Flags().Changed("clear-started") {
+			t.Started = started
+		}
+		if cmd.Flags().Changed("completed") || cmd.Flags().Changed("clear-completed") {
+			t.Completed = completed
+		}
+		recordTransition(cfg, t, oldStatus, t.Status)
+	}
 	t.Updated = time.Now()
 
-	newPath, err := writeAndRename(path, t, oldTitle)
+	newPath, err := writeAndRename(cfg, path, t, oldTitle)
 	if err != nil {
 		return nil, "", err
 	}
 
-	logEditActivity(cfg, t, wasBlocked, wasClaimedBy)
+	logEditActivity(cfg, &before, t)
+
+	advance, _ := cmd.Flags().GetBool("move-next-if-unblocked")
+	if advance && t.Status != oldStatus && cfg.IsTerminalStatus(t.Status) {
+		if err := advanceDependents(cfg, t); err != nil {
+			return nil, "", err
+		}
+	}
 	return t, newPath, nil
 }
 
+// advanceDependents moves the dependents of done that are in the initial
+// status and no longer blocked by dependencies to the next status, logging
+// each as "auto-advance". Dependents that cannot move are reported and
+// skipped. Must be called under the board lock.
+func advanceDependents(cfg *config.Config, done *task.Task) error {
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	var waiting []*task.Task
+	for _, d := range board.Dependents(tasks, done.ID) {
+		if cfg.IsInitialStatus(d.Status) {
+			waiting = append(waiting, d)
+		}
+	}
+
+	names := cfg.StatusNames()
+	for _, d := range board.FilterUnblockedWithLookup(waiting, tasks, cfg) {
+		idx := cfg.StatusIndex(d.Status)
+		if idx < 0 || idx >= len(names)-1 {
+			continue
+		}
+		next := names[idx+1]
+		if err := canAutoAdvance(cfg, d, next); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: not advancing task #%d: %v\n", d.ID, err)
+			continue
+		}
+
+		from := d.Status
+		d.Status = next
+		task.UpdateTimestamps(d, from, next, cfg)
+		recordTransition(cfg, d, from, next)
+		d.Updated = time.Now()
+		if err := task.Write(d.File, d); err != nil {
+			return fmt.Errorf("writing task #%d: %w", d.ID, err)
+		}
+		logActivityWithReason(cfg, "auto-advance", d.ID, fmt.Sprintf("%s -> %s", from, next),
+			fmt.Sprintf("dependency #%d done", done.ID))
+		if outputFormat() != output.FormatJSON {
+			output.Messagef(os.Stdout, "Advanced task #%d: %s -> %s", d.ID, from, next)
+		}
+	}
+	return nil
+}
+
+// canAutoAdvance returns why d cannot be moved to next without a claimant,
+// or nil.
+func canAutoAdvance(cfg *config.Config, d *task.Task, next string) error {
+	if err := validateMoveClaim(cfg, d, ""); err != nil {
+		return err
+	}
+	if cfg.StatusRequiresClaim(next) {
+		return task.ValidateClaimRequired(next)
+	}
+	return enforceMoveWIP(cfg, d, next)
+}
+
 // validateEditClaim checks claim ownership and require_claim before allowing edits.
 // The --release flag bypasses claim checks since its intent is to release a claim.
 func validateEditClaim(cfg *config.Config, t *task.Task, cmd *cobra.Command) (string, bool, error) {
 	claimant, _ := cmd.Flags().GetString("claim")
 	release, _ := cmd.Flags().GetBool("release")
+	setActor(claimant)
 	// --release bypasses claim check — its purpose is to release a (possibly foreign) claim.
 	if !release {
 		if err := checkClaim(t, claimant, cfg.ClaimTimeoutDuration()); err != nil {
@@ -171,32 +420,87 @@ func applyEditChanges(cmd *cobra.Command, t *task.Task, cfg *config.Config, clai
 	return changed, nil
 }
 
-// validateEditPost runs post-edit validations: deps, require_claim for new status, WIP limits.
-func validateEditPost(cfg *config.Config, t *task.Task, oldStatus, claimant string) error {
+// validateEditPost runs post-edit validations against the task as it was
+// before the edit: body size, class policy (when the class or due date
+// changed), deps, require_claim for new status, WIP limits.
+func validateEditPost(cfg *config.Config, t, before *task.Task, claimant string) error {
+	oldStatus, oldClass, oldPriority := before.Status, before.Class, before.Priority
+	if err := checkBodySize(cfg, t); err != nil {
+		return err
+	}
+	changes := editChanges(before, t)
+	_, classChanged := changes["class"]
+	_, dueChanged := changes["due"]
+	if classChanged || dueChanged {
+		if err := checkClassPolicy(cfg, t); err != nil {
+			return err
+		}
+	}
 	if err := validateDeps(cfg, t); err != nil {
 		return err
 	}
+	if t.Status == oldStatus && t.Class != oldClass {
+		if err := validateClassChange(cfg, t, oldClass); err != nil {
+			return err
+		}
+	}
 	// Enforce require_claim if status changed via --status.
 	if t.Status != oldStatus && cfg.StatusRequiresClaim(t.Status) && claimant == "" {
 		return task.ValidateClaimRequired(t.Status)
 	}
-	// Check WIP limit if status changed (class-aware).
+	// Check WIP limits if status changed (class- and priority-aware), or
+	// the priority limit alone if only the priority changed.
 	if t.Status != oldStatus {
-		if t.Class != "" && len(cfg.Classes) > 0 {
-			return enforceWIPLimitForClass(cfg, t, oldStatus, t.Status)
-		}
-		return enforceWIPLimit(cfg, oldStatus, t.Status)
+		return board.EnforceWIP(cfg, t, oldStatus, t.Status)
+	}
+	if t.Priority != oldPriority {
+		return board.EnforcePriorityWIP(cfg, t, t.Status)
 	}
 	return nil
 }
 
+// validateClassChange rejects a class change that would push the task's
+// current column over its WIP limit. This happens when a task leaves a class
+// that bypasses column WIP (e.g. expedite) and starts counting toward the
+// column again.
+func validateClassChange(cfg *config.Config, t *task.Task, oldClass string) error {
+	oldConf := cfg.ClassByName(oldClass)
+	if oldConf == nil || !oldConf.BypassColumnWIP {
+		return nil
+	}
+	if newConf := cfg.ClassByName(t.Class); newConf != nil && newConf.BypassColumnWIP {
+		return nil
+	}
+	if cfg.WIPLimit(t.Status) == 0 {
+		return nil
+	}
+
+	allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return fmt.Errorf("reading tasks for WIP check: %w", err)
+	}
+	others := make([]*task.Task, 0, len(allTasks))
+	for _, other := range allTasks {
+		if other.ID != t.ID {
+			others = append(others, other)
+		}
+	}
+	return checkWIPLimit(cfg, board.CountByStatus(others), t.Status, "")
+}
+
 // writeAndRename writes the task and renames the file if the title changed.
-func writeAndRename(path string, t *task.Task, oldTitle string) (string, error) {
+// A rename never overwrites another task's file. The new file is written and
+// synced before the old one is removed; if the removal fails the new file is
+// removed again, so a failed rename leaves the original file as it was.
+func writeAndRename(cfg *config.Config, path string, t *task.Task, oldTitle string) (string, error) {
 	newPath := path
 	if t.Title != oldTitle {
-		slug := task.GenerateSlug(t.Title)
-		filename := task.GenerateFilename(t.ID, slug)
-		newPath = filepath.Join(filepath.Dir(path), filename)
+		newPath = filepath.Join(filepath.Dir(path), task.FilenameFor(cfg, t))
+	}
+	if newPath != path {
+		if err := checkRenameTarget(cfg, newPath, t.ID); err != nil {
+			return "", err
+		}
 	}
 
 	if err := task.Write(newPath, t); err != nil {
@@ -205,17 +509,42 @@ func writeAndRename(path string, t *task.Task, oldTitle string) (string, error)
 
 	if newPath != path {
 		if err := os.Remove(path); err != nil {
+			os.Remove(newPath) //nolint:errcheck,gosec // best-effort rollback, already failing
 			return "", fmt.Errorf("removing old file: %w", err)
 		}
 	}
 	return newPath, nil
 }
 
-// logEditActivity logs the edit and any block/unblock/claim/release transitions.
-func logEditActivity(cfg *config.Config, t *task.Task, wasBlocked bool, wasClaimedBy string) {
-	logActivity(cfg, "edit", t.ID, t.Title)
+// checkRenameTarget returns an error if newPath exists and does not belong to
+// task id, judged by its frontmatter ID or, if it cannot be parsed, the ID in
+// its filename.
+func checkRenameTarget(cfg *config.Config, newPath string, id int) error {
+	if _, err := os.Stat(newPath); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("checking %s: %w", newPath, err)
+	}
+	owner, ok := cfg.FilenameFormat().ExtractID(filepath.Base(newPath))
+	if existing, err := task.Read(newPath); err == nil {
+		owner, ok = existing.ID, true
+	}
+	if ok && owner == id {
+		return nil // a stale copy of this task's own file
+	}
+	return clierr.Newf(clierr.InvalidInput,
+		"cannot rename task #%d: %s already exists and belongs to another task; choose a different title",
+		id, filepath.Base(newPath)).
+		WithDetails(map[string]any{"id": id, "file": newPath})
+}
+
+// logEditActivity logs the edit with its field changes, and any
+// block/unblock/claim/release transitions.
+func logEditActivity(cfg *config.Config, before, t *task.Task) {
+	wasBlocked, wasClaimedBy := before.Blocked, before.ClaimedBy
+	logEntry(cfg, board.LogEntry{Action: "edit", TaskID: t.ID, Detail: t.Title, Changes: editChanges(before, t)})
 	if !wasBlocked && t.Blocked {
-		logActivity(cfg, "block", t.ID, t.BlockReason)
+		logActivityWithReason(cfg, "block", t.ID, t.BlockReason, t.BlockReason)
 	}
 	if wasBlocked && !t.Blocked {
 		logActivity(cfg, "unblock", t.ID, t.Title)
@@ -284,14 +613,15 @@ func applySimpleEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config)
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("status"); v != "" {
-		if err := task.ValidateStatus(v, cfg.StatusNames()); err != nil {
+		status, err := task.ResolveStatus(cfg, v)
+		if err != nil {
 			return false, err
 		}
-		t.Status = v
+		t.Status = status
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("priority"); v != "" {
-		if err := task.ValidatePriority(v, cfg.Priorities); err != nil {
+		if err := task.ValidatePriority(v, cfg.PriorityNames()); err != nil {
 			return false, err
 		}
 		t.Priority = v
@@ -302,6 +632,9 @@ func applySimpleEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config)
 		changed = true
 	}
 	if v, _ := cmd.Flags().GetString("estimate"); v != "" {
+		if _, err := task.ParseEstimate(v); err != nil {
+			return false, task.ValidateEstimate(v, err)
+		}
 		t.Estimate = v
 		changed = true
 	}
@@ -321,11 +654,14 @@ func applySimpleEditFlags(cmd *cobra.Command, t *task.Task, cfg *config.Config)
 		t.Body = appendBody(t.Body, v, ts)
 		changed = true
 	}
-	if v, _ := cmd.Flags().GetString("class"); v != "" {
-		if err := task.ValidateClass(v, cfg.ClassNames()); err != nil {
-			return false, err
+	if cmd.Flags().Changed("class") {
+		v, _ := cmd.Flags().GetString("class")
+		if v != "" {
+			if err := task.ValidateClass(v, cfg.ClassNames()); err != nil {
+				return false, err
+			}
 		}
-		t.Class = v
+		t.Class = v // empty clears the class
 		changed = true
 	}
 
@@ -390,6 +726,18 @@ func applyTagDueFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 		t.Tags = removeAll(t.Tags, v...)
 		changed = true
 	}
+	if v, _ := cmd.Flags().GetStringArray("add-link"); len(v) > 0 {
+		links, err := task.ParseLinks(v)
+		if err != nil {
+			return false, err
+		}
+		t.Links = task.AddLinks(t.Links, links...)
+		changed = true
+	}
+	if v, _ := cmd.Flags().GetStringArray("remove-link"); len(v) > 0 {
+		t.Links = task.RemoveLinks(t.Links, v...)
+		changed = true
+	}
 	if v, _ := cmd.Flags().GetString("due"); v != "" {
 		d, err := date.Parse(v)
 		if err != nil {
@@ -437,6 +785,64 @@ func applyDepFlags(cmd *cobra.Command, t *task.Task) (bool, error) {
 	return changed, nil
 }
 
+// editChanges returns the fields that differ between before and after,
+// formatted for the activity log. The body is summarized by its length.
+func editChanges(before, after *task.Task) map[string]board.FieldChange {
+	fields := []struct {
+		name string
+		get  func(*task.Task) string
+	}{
+		{"title", func(t *task.Task) string { return t.Title }},
+		{"status", func(t *task.Task) string { return t.Status }},
+		{"priority", func(t *task.Task) string { return t.Priority }},
+		{"assignee", func(t *task.Task) string { return t.Assignee }},
+		{"tags", func(t *task.Task) string { return strings.Join(t.Tags, ",") }},
+		{"links", func(t *task.Task) string { return task.FormatLinks(t.Links) }},
+		{"due", func(t *task.Task) string {
+			if t.Due == nil {
+				return ""
+			}
+			return t.Due.String()
+		}},
+		{"estimate", func(t *task.Task) string { return t.Estimate }},
+		{"parent", func(t *task.Task) string {
+			if t.Parent == nil {
+				return ""
+			}
+			return strconv.Itoa(*t.Parent)
+		}},
+		{"depends_on", func(t *task.Task) string { return fmt.Sprint(t.DependsOn) }},
+		{"class", func(t *task.Task) string { return t.Class }},
+		{"blocked", func(t *task.Task) string { return strconv.FormatBool(t.Blocked) }},
+		{"block_reason", func(t *task.Task) string { return t.BlockReason }},
+		{"claimed_by", func(t *task.Task) string { return t.ClaimedBy }},
+		{"started", func(t *task.Task) string { return formatTimePtr(t.Started) }},
+		{"completed", func(t *task.Task) string { return formatTimePtr(t.Completed) }},
+	}
+
+	changes := make(map[string]board.FieldChange)
+	for _, f := range fields {
+		if old, cur := f.get(before), f.get(after); old != cur {
+			changes[f.name] = board.FieldChange{Old: old, New: cur}
+		}
+	}
+	if before.Body != after.Body {
+		changes["body"] = board.FieldChange{
+			Old: fmt.Sprintf("%d bytes", len(before.Body)),
+			New: fmt.Sprintf("%d bytes", len(after.Body)),
+		}
+	}
+	return changes
+}
+
+// formatTimePtr formats an optional timestamp as RFC 3339, or "" when nil.
+func formatTimePtr(ts *time.Time) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.Format(time.RFC3339)
+}
+
 func appendUniqueInts(slice []int, items ...int) []int {
 	seen := make(map[int]bool, len(slice))
 	for _, v := range slice {
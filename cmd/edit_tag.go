@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var editTagCmd = &cobra.Command{
+	Use:   "edit-tag",
+	Short: "Rename or delete a tag on every task",
+	Long: `Board-wide tag maintenance. Every task carrying the tag is rewritten
+under the board lock, and each change is logged.`,
+}
+
+var editTagRenameCmd = &cobra.Command{
+	Use:   "rename OLD NEW",
+	Short: "Rename a tag on every task",
+	Long: `Replaces OLD with NEW in the tags of every task. Tasks that already
+carry NEW keep a single copy.`,
+	Args: cobra.ExactArgs(2), //nolint:mnd // old and new tag
+	RunE: runEditTagRename,
+}
+
+var editTagDeleteCmd = &cobra.Command{
+	Use:   "delete TAG",
+	Short: "Remove a tag from every task",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runEditTagDelete,
+}
+
+func init() {
+	editTagCmd.AddCommand(editTagRenameCmd)
+	editTagCmd.AddCommand(editTagDeleteCmd)
+	rootCmd.AddCommand(editTagCmd)
+}
+
+// editTagResult is the JSON output of edit-tag.
+type editTagResult struct {
+	Tag     string `json:"tag"`
+	NewTag  string `json:"new_tag,omitempty"`
+	Changed []int  `json:"changed"`
+}
+
+func runEditTagRename(_ *cobra.Command, args []string) error {
+	oldTag, newTag := args[0], args[1]
+	if newTag == "" {
+		return clierr.New(clierr.InvalidInput, "new tag name must not be empty")
+	}
+	if oldTag == newTag {
+		return clierr.Newf(clierr.InvalidInput, "tag %q would be renamed to itself", oldTag)
+	}
+
+	detail := fmt.Sprintf("%s -> %s", oldTag, newTag)
+	ids, err := rewriteTags(oldTag, "tag-rename", detail, func(t *task.Task) {
+		t.Tags = appendUnique(removeAll(t.Tags, oldTag), newTag)
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, editTagResult{Tag: oldTag, NewTag: newTag, Changed: ids})
+	}
+	output.Messagef(os.Stdout, "Renamed tag %s to %s on %d task(s)", oldTag, newTag, len(ids))
+	return nil
+}
+
+func runEditTagDelete(_ *cobra.Command, args []string) error {
+	tag := args[0]
+
+	ids, err := rewriteTags(tag, "tag-delete", tag, func(t *task.Task) {
+		t.Tags = removeAll(t.Tags, tag)
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, editTagResult{Tag: tag, Changed: ids})
+	}
+	output.Messagef(os.Stdout, "Removed tag %s from %d task(s)", tag, len(ids))
+	return nil
+}
+
+// rewriteTags applies change to every task tagged tag, writes it and logs
+// action with detail, all under the board lock. Task files are read strictly
+// so a malformed file aborts before any task is modified. Returns the IDs of
+// the changed tasks.
+func rewriteTags(tag, action, detail string, change func(*task.Task)) ([]int, error) {
+	dir, err := resolveDir()
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := board.Lock(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []int{}
+	now := time.Now()
+	for _, t := range tasks {
+		if !slices.Contains(t.Tags, tag) {
+			continue
+		}
+		change(t)
+		t.Updated = now
+		if err := task.Write(t.File, t); err != nil {
+			return ids, fmt.Errorf("writing task #%d: %w", t.ID, err)
+		}
+		logActivity(cfg, action, t.ID, detail)
+		ids = append(ids, t.ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestConcurrentMovesRespectWIPLimit(t *testing.T) {
+	const racers = 6
+	tests := []struct {
+		name string
+		args func(id string) []string
+	}{
+		{"edit", func(id string) []string { return []string{"edit", id, "--status", "todo"} }},
+		{"move", func(id string) []string { return []string{"move", id, "todo"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			titles := make([]string, racers)
+			for i := range titles {
+				titles[i] = "racer"
+			}
+			root, cfg := newTestBoard(t, func(c *config.Config) {
+				c.WIPLimits = map[string]int{"todo": 1}
+			}, titles...)
+
+			var wg sync.WaitGroup
+			errs := make([]error, racers)
+			for i := range racers {
+				wg.Go(func() {
+					out, err := runCLI(t, root, tt.args(strconv.Itoa(i+1))...)
+					if err != nil && !strings.Contains(string(out), "WIP limit reached") {
+						t.Errorf("%s #%d: %v: %s", tt.name, i+1, err, out)
+					}
+					errs[i] = err
+				})
+			}
+			wg.Wait()
+
+			succeeded := 0
+			for _, err := range errs {
+				if err == nil {
+					succeeded++
+				}
+			}
+			tasks, err := task.ReadAll(cfg.TasksPath())
+			if err != nil {
+				t.Fatal(err)
+			}
+			inTodo := 0
+			for _, tk := range tasks {
+				if tk.Status == "todo" {
+					inTodo++
+				}
+			}
+			if succeeded != 1 || inTodo != 1 {
+				t.Errorf("%d %ss succeeded and %d tasks are in todo, want 1 and 1", succeeded, tt.name, inTodo)
+			}
+		})
+	}
+}
+
+func TestEditClassPolicyOnlyOnClassOrDueChange(t *testing.T) {
+	root, cfg := newTestBoard(t, nil, "no due date")
+	// Tighten the policy after the task exists, as a config change would.
+	for i := range cfg.Classes {
+		if cfg.Classes[i].Name == "standard" {
+			cfg.Classes[i].RequireDue = true
+		}
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{"unrelated field", []string{"--priority", "high"}, false},
+		{"class change", []string{"--class", "fixed-date"}, true},
+		{"due set", []string{"--due", "2030-01-01"}, false},
+		{"due cleared", []string{"--clear-due"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := runCLI(t, root, append([]string{"edit", "1"}, tt.args...)...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("edit %v: err = %v, want error %v: %s", tt.args, err, tt.wantErr, out)
+			}
+			if tt.wantErr && !strings.Contains(string(out), "requires a due date") {
+				t.Errorf("edit %v output = %s, want class policy error", tt.args, out)
+			}
+		})
+	}
+}
+
+// writeTaskFile writes raw content to name in cfg's tasks directory.
+func writeTaskFile(t *testing.T, cfg *config.Config, name, content string) string {
+	t.Helper()
+	path := filepath.Join(cfg.TasksPath(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCheckRenameTarget(t *testing.T) {
+	_, cfg := newTestBoard(t, nil)
+	own := writeTaskFile(t, cfg, "001-own.md", "---\nid: 1\ntitle: own\n---\n")
+	other := writeTaskFile(t, cfg, "001-other.md", "---\nid: 2\ntitle: other\n---\n")
+	brokenOwn := writeTaskFile(t, cfg, "001-broken.md", "not a task file")
+	brokenOther := writeTaskFile(t, cfg, "002-broken.md", "not a task file")
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{"free name", filepath.Join(cfg.TasksPath(), "001-free.md"), false},
+		{"stale copy of the same task", own, false},
+		{"another task's file", other, true},
+		{"unparseable file named for the task", brokenOwn, false},
+		{"unparseable file named for another task", brokenOther, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRenameTarget(cfg, tt.path, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkRenameTarget(%s) = %v, want error %v", filepath.Base(tt.path), err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteAndRename(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string // content already at the new filename, if any
+		wantErr  bool
+	}{
+		{"plain rename", "", false},
+		{"collision with another task", "---\nid: 2\ntitle: New title\n---\n", true},
+		// A crash between writing the new file and removing the old one
+		// leaves both; the next rename must reuse the leftover.
+		{"leftover from an interrupted rename", "---\nid: 1\ntitle: New title\n---\nstale\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, cfg := newTestBoard(t, nil, "Old title")
+			oldPath := filepath.Join(cfg.TasksPath(), "001-old-title.md")
+			newPath := filepath.Join(cfg.TasksPath(), "001-new-title.md")
+			if tt.existing != "" {
+				writeTaskFile(t, cfg, filepath.Base(newPath), tt.existing)
+			}
+			tk, err := task.Read(oldPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tk.Title = "New title"
+
+			got, err := writeAndRename(cfg, oldPath, tk, "Old title")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("writeAndRename succeeded, want collision error")
+				}
+				assertTaskFile(t, oldPath, 1, "Old title")
+				assertTaskFile(t, newPath, 2, "New title")
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != newPath {
+				t.Errorf("new path = %s, want %s", got, newPath)
+			}
+			if _, err := os.Stat(oldPath); !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("old file still present: %v", err)
+			}
+			assertTaskFile(t, newPath, 1, "New title")
+		})
+	}
+}
+
+func assertTaskFile(t *testing.T, path string, id int, title string) {
+	t.Helper()
+	tk, err := task.Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk.ID != id || tk.Title != title || strings.Contains(tk.Body, "stale") {
+		t.Errorf("%s = #%d %q body %q, want #%d %q", filepath.Base(path), tk.ID, tk.Title, tk.Body, id, title)
+	}
+}
+
+func TestEditStatusUpdatesTimestamps(t *testing.T) {
+	root, cfg := newTestBoard(t, nil, "blocker", "dependent")
+	if out, err := runCLI(t, root, "edit", "2", "--add-dep", "1"); err != nil {
+		t.Fatalf("add dep: %v\n%s", err, out)
+	}
+
+	if out, err := runCLI(t, root, "edit", "1", "--status", "done", "--move-next-if-unblocked"); err != nil {
+		t.Fatalf("edit: %v\n%s", err, out)
+	}
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk := tasks[0]; tk.Started == nil || tk.Completed == nil {
+		t.Errorf("task #1 started %v, completed %v; want both set", tk.Started, tk.Completed)
+	}
+
+	// After reopening, an explicit --completed wins over the move's own time.
+	if out, err := runCLI(t, root, "edit", "1", "--status", "todo"); err != nil {
+		t.Fatalf("reopen: %v\n%s", err, out)
+	}
+	if out, err := runCLI(t, root, "edit", "1", "--status", "done", "--completed", "2026-01-02"); err != nil {
+		t.Fatalf("complete: %v\n%s", err, out)
+	}
+	tasks, err = task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := tasks[0].Completed; c == nil || c.Format("2006-01-02") != "2026-01-02" {
+		t.Errorf("task #1 completed %v, want the explicit 2026-01-02", c)
+	}
+}
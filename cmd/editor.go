@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// defaultEditor is used when $EDITOR is not set.
+const defaultEditor = "vi"
+
+// editInEditor opens $EDITOR on the task's file content and returns the
+// edited task. The task ID and file path cannot be changed. Returns a
+// NoChanges error if the editor fails or the content is left unchanged.
+func editInEditor(cfg *config.Config, t *task.Task) (*task.Task, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return nil, clierr.New(clierr.InvalidInput, "--edit requires an interactive terminal")
+	}
+
+	original, err := task.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.CreateTemp("", "agentwatch-*.md")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp file: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	_, err = f.Write(original)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("writing temp file: %w", err)
+	}
+
+	if err := runEditor(tmp); err != nil {
+		return nil, clierr.Newf(clierr.NoChanges, "editor failed (%v); no changes made", err)
+	}
+
+	edited, err := os.ReadFile(tmp) //nolint:gosec // temp file created above
+	if err != nil {
+		return nil, fmt.Errorf("reading temp file: %w", err)
+	}
+	if bytes.Equal(edited, original) {
+		return nil, clierr.New(clierr.NoChanges, "no changes made in editor")
+	}
+
+	nt, err := task.Parse(edited)
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid task file: %v", err)
+	}
+	if err := validateEditedTask(cfg, nt); err != nil {
+		return nil, err
+	}
+	nt.ID = t.ID
+	nt.File = t.File
+	return nt, nil
+}
+
+// runEditor runs $EDITOR (which may include arguments) on path, attached to
+// the terminal.
+func runEditor(path string) error {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+
+	c := exec.Command(editor[0], append(editor[1:], path)...) //nolint:gosec // user-chosen editor
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	return c.Run()
+}
+
+// validateEditedTask checks the fields a user may have changed by hand.
+func validateEditedTask(cfg *config.Config, t *task.Task) error {
+	if strings.TrimSpace(t.Title) == "" {
+		return clierr.New(clierr.InvalidInput, "title is required")
+	}
+	status, err := task.ResolveStatus(cfg, t.Status)
+	if err != nil {
+		return err
+	}
+	t.Status = status
+	if err := task.ValidatePriority(t.Priority, cfg.PriorityNames()); err != nil {
+		return err
+	}
+	if t.Class != "" {
+		if err := task.ValidateClass(t.Class, cfg.ClassNames()); err != nil {
+			return err
+		}
+	}
+	if t.Estimate != "" {
+		if _, err := task.ParseEstimate(t.Estimate); err != nil {
+			return task.ValidateEstimate(t.Estimate, err)
+		}
+	}
+	return nil
+}
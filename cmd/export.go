@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// snapshotVersion is the format version written by export and accepted by
+// import-snapshot.
+const snapshotVersion = 1
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the whole board as a single JSON snapshot",
+	Long: `Writes the board config and every task, archived included, to one JSON
+document for backup or sharing. The snapshot is written to stdout unless
+--out is given; restore it with import-snapshot.
+
+Exporting only reads the board.`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+func init() {
+	exportCmd.Flags().String("out", "", "write the snapshot to this file instead of stdout")
+	rootCmd.AddCommand(exportCmd)
+}
+
+// boardSnapshot is a portable representation of an entire board. Config is
+// keyed like config.yml.
+type boardSnapshot struct {
+	SnapshotVersion int            `json:"snapshot_version"`
+	ExportedAt      time.Time      `json:"exported_at"`
+	Config          map[string]any `json:"config"`
+	Tasks           []*task.Task   `json:"tasks"`
+}
+
+func runExport(cmd *cobra.Command, _ []string) error {
+	out, _ := cmd.Flags().GetString("out")
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	// Load directly rather than via loadConfig so a missing board is
+	// reported instead of created.
+	cfg, err := config.Load(dir)
+	if errors.Is(err, config.ErrNotFound) {
+		return clierr.Newf(clierr.BoardNotFound, "no board found in %s", dir).
+			WithDetails(map[string]any{"dir": dir})
+	}
+	if err != nil {
+		return err
+	}
+
+	cfgMap, err := cfg.AsMap()
+	if err != nil {
+		return err
+	}
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	board.SortByID(tasks)
+	for _, t := range tasks {
+		t.File = "" // paths are local to this machine
+	}
+
+	snap := boardSnapshot{
+		SnapshotVersion: snapshotVersion,
+		ExportedAt:      time.Now().UTC(),
+		Config:          cfgMap,
+		Tasks:           tasks,
+	}
+	if out == "" {
+		return output.JSON(os.Stdout, snap)
+	}
+
+	if err := writeSnapshot(out, snap); err != nil {
+		return err
+	}
+	output.Messagef(os.Stdout, "Exported %d tasks to %s", len(tasks), out)
+	return nil
+}
+
+// writeSnapshot writes snap as indented JSON to path.
+func writeSnapshot(path string, snap boardSnapshot) error {
+	const fileMode = 0o600
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, fileMode) //nolint:gosec // user-chosen output path
+	if err != nil {
+		return fmt.Errorf("writing snapshot: %w", err)
+	}
+	if err := output.JSON(f, snap); err != nil {
+		f.Close() //nolint:errcheck,gosec // already failing
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,426 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import tasks from a CSV or JSON file",
+	Long: `Creates tasks in bulk from a CSV file (with a header row) or a JSON array
+of objects. Recognized fields: id, title, status, priority, assignee, tags,
+due, estimate, class, body. Tags in CSV are separated by ";" or ",". Rows
+with an id keep it (it must not be in use); the others get the next free IDs.
+
+Use --map to read a field from a differently named column, for example
+--map title:Summary,status:Status for a Jira export.
+
+All rows are validated against the board config before anything is written;
+any invalid row aborts the import. Imported tasks are then created like
+"create" would: WIP limits and class policies apply, and a refused task
+rolls back the whole import. Rows without any values are skipped.`,
+	Args: cobra.NoArgs,
+	RunE: runImport,
+}
+
+func init() {
+	importCmd.Flags().String("file", "", "CSV or JSON file to import (required)")
+	importCmd.Flags().StringSlice("map", nil, "field:column mappings (e.g. title:Summary,status:Status)")
+	importCmd.Flags().Bool("dry-run", false, "validate and print the tasks without writing")
+	_ = importCmd.MarkFlagRequired("file")
+	rootCmd.AddCommand(importCmd)
+}
+
+// importFields lists the task fields that can be imported.
+var importFields = []string{"id", "title", "status", "priority", "assignee", "tags", "due", "estimate", "class", "body"}
+
+// importRecord is one source row keyed by column name.
+type importRecord struct {
+	Row    int // 1-based source position (CSV rows count the header)
+	Values map[string]string
+}
+
+// importRowError describes a validation failure on one source row.
+type importRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// importResult is the JSON output of the import command.
+type importResult struct {
+	Created []int        `json:"created"`
+	Skipped []int        `json:"skipped"`
+	DryRun  bool         `json:"dry_run,omitempty"`
+	Tasks   []*task.Task `json:"tasks,omitempty"`
+}
+
+func runImport(cmd *cobra.Command, _ []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	mapPairs, _ := cmd.Flags().GetStringSlice("map")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	mapping, err := parseImportMap(mapPairs)
+	if err != nil {
+		return err
+	}
+
+	records, err := readImportFile(file)
+	if err != nil {
+		return err
+	}
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	unlock, err := board.Lock(dir)
+	if err != nil {
+		return err
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	cfg, err := config.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var tasks []*task.Task
+	var skipped []int
+	var rowErrs []importRowError
+	for _, rec := range records {
+		if rec.empty() {
+			skipped = append(skipped, rec.Row)
+			continue
+		}
+		t, buildErr := buildImportedTask(cfg, rec, mapping, now)
+		if buildErr == nil {
+			buildErr = importedID(cfg, t, rec.field(mapping, "id"), tasks)
+		}
+		if buildErr != nil {
+			rowErrs = append(rowErrs, importRowError{Row: rec.Row, Error: buildErr.Error()})
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+
+	if len(rowErrs) > 0 {
+		return importValidationError(rowErrs)
+	}
+	assignImportIDs(cfg, tasks)
+
+	if !dryRun {
+		if err := writeImportedTasks(cfg, tasks); err != nil {
+			return err
+		}
+	}
+
+	return outputImportResult(tasks, skipped, dryRun)
+}
+
+// parseImportMap parses "field:column" pairs into a field → column map.
+func parseImportMap(pairs []string) (map[string]string, error) {
+	mapping := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		field, column, ok := strings.Cut(pair, ":")
+		if !ok || field == "" || column == "" {
+			return nil, clierr.Newf(clierr.InvalidInput, "invalid --map entry %q (expected field:column)", pair)
+		}
+		if !slices.Contains(importFields, field) {
+			return nil, clierr.Newf(clierr.InvalidInput, "unknown import field %q; valid: %s",
+				field, strings.Join(importFields, ", "))
+		}
+		mapping[field] = column
+	}
+	return mapping, nil
+}
+
+// readImportFile parses a CSV or JSON file into records. The format is chosen
+// by file extension, defaulting to CSV.
+func readImportFile(path string) ([]importRecord, error) {
+	f, err := os.Open(path) //nolint:gosec // user-provided import file
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "opening import file: %v", err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return readImportJSON(f)
+	}
+	return readImportCSV(f)
+}
+
+func readImportCSV(r io.Reader) ([]importRecord, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "parsing CSV: %v", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	records := make([]importRecord, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		values := make(map[string]string, len(header))
+		for j, col := range header {
+			if j < len(row) {
+				values[strings.TrimSpace(col)] = row[j]
+			}
+		}
+		records = append(records, importRecord{Row: i + 2, Values: values}) //nolint:mnd // header is row 1
+	}
+	return records, nil
+}
+
+func readImportJSON(r io.Reader) ([]importRecord, error) {
+	var objects []map[string]any
+	if err := json.NewDecoder(r).Decode(&objects); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "parsing JSON (expected an array of objects): %v", err)
+	}
+
+	records := make([]importRecord, 0, len(objects))
+	for i, obj := range objects {
+		rec := importRecord{Row: i + 1, Values: make(map[string]string, len(obj))}
+		for k, v := range obj {
+			switch val := v.(type) {
+			case nil:
+			case string:
+				rec.Values[k] = val
+			case []any:
+				parts := make([]string, 0, len(val))
+				for _, item := range val {
+					parts = append(parts, fmt.Sprint(item))
+				}
+				rec.Values[k] = strings.Join(parts, ";")
+			default:
+				rec.Values[k] = fmt.Sprint(val)
+			}
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// empty reports whether the record has no non-blank values.
+func (r importRecord) empty() bool {
+	for _, v := range r.Values {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// field returns the value for a task field, honoring the column mapping.
+func (r importRecord) field(mapping map[string]string, name string) string {
+	col := name
+	if mapped, ok := mapping[name]; ok {
+		col = mapped
+	}
+	return strings.TrimSpace(r.Values[col])
+}
+
+// buildImportedTask converts a record into a task with config defaults and
+// validates it. The ID is assigned by the caller.
+func buildImportedTask(cfg *config.Config, rec importRecord, mapping map[string]string, now time.Time) (*task.Task, error) {
+	title := rec.field(mapping, "title")
+	if title == "" {
+		return nil, clierr.New(clierr.InvalidInput, "title is required")
+	}
+
+	t := &task.Task{
+		Title:    title,
+		Status:   cfg.Defaults.Status,
+		Priority: cfg.Defaults.Priority,
+		Class:    cfg.Defaults.Class,
+		Created:  now,
+		Updated:  now,
+		Assignee: rec.field(mapping, "assignee"),
+		Body:     rec.field(mapping, "body"),
+	}
+
+	if v := rec.field(mapping, "status"); v != "" {
+		status, err := task.ResolveStatus(cfg, v)
+		if err != nil {
+			return nil, err
+		}
+		t.Status = status
+	}
+	if v := rec.field(mapping, "priority"); v != "" {
+		if err := task.ValidatePriority(v, cfg.PriorityNames()); err != nil {
+			return nil, err
+		}
+		t.Priority = v
+	}
+	if v := rec.field(mapping, "class"); v != "" {
+		if err := task.ValidateClass(v, cfg.ClassNames()); err != nil {
+			return nil, err
+		}
+		t.Class = v
+	}
+	if v := rec.field(mapping, "due"); v != "" {
+		d, err := date.Parse(v)
+		if err != nil {
+			return nil, task.FormatDueDate(v, err)
+		}
+		t.Due = &d
+	}
+	if v := rec.field(mapping, "estimate"); v != "" {
+		if _, err := task.ParseEstimate(v); err != nil {
+			return nil, task.ValidateEstimate(v, err)
+		}
+		t.Estimate = v
+	}
+	if v := rec.field(mapping, "tags"); v != "" {
+		t.Tags = splitImportTags(v)
+	}
+	return t, nil
+}
+
+// importedID sets t.ID from an id column value, checking that neither the
+// board nor an earlier row uses it. An empty value leaves the ID for
+// assignImportIDs.
+func importedID(cfg *config.Config, t *task.Task, v string, earlier []*task.Task) error {
+	if v == "" {
+		return nil
+	}
+	id, err := strconv.Atoi(v)
+	if err != nil {
+		return task.ValidateTaskID(v)
+	}
+	if err := checkIDFree(cfg, id); err != nil {
+		return err
+	}
+	for _, e := range earlier {
+		if e.ID == id {
+			return clierr.Newf(clierr.TaskIDExists, "task #%d is used by an earlier row", id).
+				WithDetails(map[string]any{"id": id})
+		}
+	}
+	t.ID = id
+	return nil
+}
+
+// assignImportIDs gives tasks without an explicit ID the next free IDs from
+// next_id, skipping the explicit ones.
+func assignImportIDs(cfg *config.Config, tasks []*task.Task) {
+	taken := make(map[int]bool, len(tasks))
+	for _, t := range tasks {
+		taken[t.ID] = true
+	}
+	next := cfg.NextID
+	for _, t := range tasks {
+		if t.ID != 0 {
+			continue
+		}
+		for taken[next] {
+			next++
+		}
+		t.ID = next
+		taken[next] = true
+	}
+}
+
+// splitImportTags splits a tag list on ";" or ",", dropping blanks.
+func splitImportTags(s string) []string {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == ',' })
+	tags := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			tags = appendUnique(tags, p)
+		}
+	}
+	return tags
+}
+
+// importValidationError reports every invalid row in one error.
+func importValidationError(rowErrs []importRowError) error {
+	lines := make([]string, 0, len(rowErrs))
+	for _, e := range rowErrs {
+		lines = append(lines, "row "+strconv.Itoa(e.Row)+": "+e.Error)
+	}
+	return clierr.Newf(clierr.InvalidInput, "import aborted, %d invalid rows:\n  %s",
+		len(rowErrs), strings.Join(lines, "\n  ")).
+		WithDetails(map[string]any{"errors": rowErrs})
+}
+
+// writeImportedTasks writes all task files like create does (WIP limits,
+// class policy, timestamps, history) and bumps next_id once. If any task is
+// refused, the files already written are removed. The caller must hold the
+// board lock.
+func writeImportedTasks(cfg *config.Config, tasks []*task.Task) error {
+	var written []string
+	for _, t := range tasks {
+		err := enforceCreateWIP(cfg, t)
+		if err == nil {
+			_, err = writeNewTask(cfg, t)
+		}
+		if err != nil {
+			for _, path := range written {
+				_ = os.Remove(path)
+			}
+			return fmt.Errorf("importing task #%d %q: %w", t.ID, t.Title, err)
+		}
+		written = append(written, t.File)
+	}
+
+	if len(tasks) == 0 {
+		return nil
+	}
+	for _, t := range tasks {
+		cfg.NextID = max(cfg.NextID, t.ID+1)
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+
+	logActivity(cfg, "import", 0, fmt.Sprintf("%d tasks", len(tasks)))
+	return nil
+}
+
+func outputImportResult(tasks []*task.Task, skipped []int, dryRun bool) error {
+	ids := make([]int, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+	if skipped == nil {
+		skipped = []int{}
+	}
+
+	if outputFormat() == output.FormatJSON {
+		res := importResult{Created: ids, Skipped: skipped, DryRun: dryRun}
+		if dryRun {
+			res.Tasks = tasks
+		}
+		return output.JSON(os.Stdout, res)
+	}
+
+	if dryRun {
+		output.TaskTable(os.Stdout, tasks)
+		output.Messagef(os.Stdout, "Dry run: would import %d tasks (%d rows skipped)", len(tasks), len(skipped))
+		return nil
+	}
+	output.Messagef(os.Stdout, "Imported %d tasks (%d rows skipped)", len(tasks), len(skipped))
+	return nil
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var importSnapshotCmd = &cobra.Command{
+	Use:   "import-snapshot FILE",
+	Short: "Recreate a board from an export snapshot",
+	Long: `Recreates a board from a snapshot written by export: the config is
+written and every task file is recreated with its original ID. The target
+board (see --dir) must not exist yet. Use "-" to read the snapshot from
+stdin.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImportSnapshot,
+}
+
+func init() {
+	rootCmd.AddCommand(importSnapshotCmd)
+}
+
+func runImportSnapshot(_ *cobra.Command, args []string) error {
+	snap, err := readSnapshot(args[0])
+	if err != nil {
+		return err
+	}
+
+	dir, err := resolveDir()
+	if err != nil {
+		return err
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("resolving path: %w", err)
+	}
+	if _, err := os.Stat(filepath.Join(absDir, config.ConfigFileName)); err == nil {
+		return clierr.Newf(clierr.BoardAlreadyExists, "board already initialized in %s", absDir).
+			WithDetails(map[string]any{"dir": absDir})
+	}
+
+	cfg, err := snapshotConfig(snap, absDir)
+	if err != nil {
+		return err
+	}
+
+	const dirMode = 0o750
+	if err := os.MkdirAll(cfg.TasksPath(), dirMode); err != nil {
+		return fmt.Errorf("creating tasks directory: %w", err)
+	}
+	unlock, err := board.Lock(absDir)
+	if err != nil {
+		return err
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("writing config: %w", err)
+	}
+	for _, t := range snap.Tasks {
+		path := filepath.Join(cfg.TasksPath(), task.FilenameFor(cfg, t))
+		if err := task.Write(path, t); err != nil {
+			return fmt.Errorf("writing task #%d: %w", t.ID, err)
+		}
+	}
+	logActivity(cfg, "import-snapshot", 0, fmt.Sprintf("%d tasks", len(snap.Tasks)))
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]any{
+			"dir":     absDir,
+			"name":    cfg.Board.Name,
+			"tasks":   len(snap.Tasks),
+			"next_id": cfg.NextID,
+		})
+	}
+	output.Messagef(os.Stdout, "Imported board %q with %d tasks into %s", cfg.Board.Name, len(snap.Tasks), absDir)
+	return nil
+}
+
+// readSnapshot decodes a snapshot file ("-" for stdin) and checks its version.
+func readSnapshot(path string) (*boardSnapshot, error) {
+	in := os.Stdin
+	if path != "-" {
+		f, err := os.Open(path) //nolint:gosec // user-supplied snapshot path
+		if err != nil {
+			return nil, fmt.Errorf("opening snapshot: %w", err)
+		}
+		defer f.Close()
+		in = f
+	}
+
+	var snap boardSnapshot
+	if err := json.NewDecoder(in).Decode(&snap); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid snapshot: %v", err)
+	}
+	if snap.SnapshotVersion != snapshotVersion {
+		return nil, clierr.Newf(clierr.InvalidInput,
+			"unsupported snapshot version %d (expected %d)", snap.SnapshotVersion, snapshotVersion).
+			WithDetails(map[string]any{"version": snap.SnapshotVersion})
+	}
+	if snap.Config == nil {
+		return nil, clierr.New(clierr.InvalidInput, "invalid snapshot: missing config")
+	}
+	return &snap, nil
+}
+
+// snapshotConfig rebuilds and validates the snapshot's config for dir. Task
+// IDs must be unique; next_id is raised past the highest one.
+func snapshotConfig(snap *boardSnapshot, dir string) (*config.Config, error) {
+	cfg, err := config.FromMap(snap.Config)
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid snapshot config: %v", err)
+	}
+	cfg.SetDir(dir)
+
+	seen := make(map[int]bool, len(snap.Tasks))
+	for _, t := range snap.Tasks {
+		if t.ID < 1 || seen[t.ID] {
+			return nil, clierr.Newf(clierr.InvalidInput, "invalid snapshot: duplicate or invalid task ID %d", t.ID).
+				WithDetails(map[string]any{"id": t.ID})
+		}
+		seen[t.ID] = true
+		cfg.NextID = max(cfg.NextID, t.ID+1)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "invalid snapshot config: %v", err)
+	}
+	return cfg, nil
+}
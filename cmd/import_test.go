@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestImportCreatesTasksLikeCreate(t *testing.T) {
+	root, cfg := newTestBoard(t, nil)
+	csv := filepath.Join(t.TempDir(), "in.csv")
+	if err := os.WriteFile(csv, []byte("title,status\nFirst,in-progress\nSecond,\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if out, err := runCLI(t, root, "import", "--file", csv); err != nil {
+		t.Fatalf("import: %v: %s", err, out)
+	}
+
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("got %d tasks, want 2", len(tasks))
+	}
+	for _, tk := range tasks {
+		if len(tk.History) != 1 || tk.History[0].To != tk.Status {
+			t.Errorf("task #%d history = %+v, want creation in %s", tk.ID, tk.History, tk.Status)
+		}
+		if started := tk.Started != nil; started != (tk.Status == "in-progress") {
+			t.Errorf("task #%d in %s: Started = %v", tk.ID, tk.Status, tk.Started)
+		}
+	}
+}
+
+func TestImportRespectsWIPLimitAndRollsBack(t *testing.T) {
+	root, cfg := newTestBoard(t, func(c *config.Config) {
+		c.WIPLimits = map[string]int{"todo": 1}
+	})
+	csv := filepath.Join(t.TempDir(), "in.csv")
+	if err := os.WriteFile(csv, []byte("title,status\nOne,todo\nTwo,todo\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := runCLI(t, root, "import", "--file", csv)
+	if err == nil || !strings.Contains(string(out), "WIP limit reached") {
+		t.Fatalf("import = %v: %s, want WIP limit error", err, out)
+	}
+
+	tasks, err := task.ReadAll(cfg.TasksPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 0 {
+		t.Errorf("%d tasks left after refused import, want 0", len(tasks))
+	}
+	reloaded, err := config.Load(filepath.Dir(cfg.ConfigPath()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.NextID != 1 {
+		t.Errorf("next_id = %d, want 1", reloaded.NextID)
+	}
+}
@@ -93,14 +93,7 @@ func runInit(cmd *cobra.Command, _ []string) error {
 	// Output result.
 	format := outputFormat()
 	if format == output.FormatJSON {
-		return output.JSON(os.Stdout, map[string]string{
-			"status":  "initialized",
-			"dir":     absDir,
-			"name":    name,
-			"config":  cfg.ConfigPath(),
-			"tasks":   tasksDir,
-			"columns": strings.Join(cfg.StatusNames(), ","),
-		})
+		return output.JSON(os.Stdout, newInitResult(cfg, absDir, tasksDir))
 	}
 
 	output.Messagef(os.Stdout, "Initialized board %q in %s", name, absDir)
@@ -111,6 +104,44 @@ func runInit(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// initResult is the JSON output of init. Besides the paths it reports the
+// resolved config so scripted provisioning can verify what was created.
+type initResult struct {
+	Status          string                  `json:"status"`
+	Dir             string                  `json:"dir"`
+	Name            string                  `json:"name"`
+	Config          string                  `json:"config"`
+	Tasks           string                  `json:"tasks"`
+	Columns         string                  `json:"columns"`
+	Statuses        []config.StatusConfig   `json:"statuses"`
+	Priorities      []config.PriorityConfig `json:"priorities"`
+	DefaultStatus   string                  `json:"default_status"`
+	DefaultPriority string                  `json:"default_priority"`
+	DefaultClass    string                  `json:"default_class,omitempty"`
+	WIPLimits       map[string]int          `json:"wip_limits,omitempty"`
+	Classes         []config.ClassConfig    `json:"classes,omitempty"`
+	ClaimTimeout    string                  `json:"claim_timeout,omitempty"`
+}
+
+func newInitResult(cfg *config.Config, absDir, tasksDir string) initResult {
+	return initResult{
+		Status:          "initialized",
+		Dir:             absDir,
+		Name:            cfg.Board.Name,
+		Config:          cfg.ConfigPath(),
+		Tasks:           tasksDir,
+		Columns:         strings.Join(cfg.StatusNames(), ","),
+		Statuses:        cfg.Statuses,
+		Priorities:      cfg.Priorities,
+		DefaultStatus:   cfg.Defaults.Status,
+		DefaultPriority: cfg.Defaults.Priority,
+		DefaultClass:    cfg.Defaults.Class,
+		WIPLimits:       cfg.WIPLimits,
+		Classes:         cfg.Classes,
+		ClaimTimeout:    cfg.ClaimTimeoutValue(),
+	}
+}
+
 // parseWIPLimits parses "status:N" pairs into a map.
 func parseWIPLimits(pairs []string) (map[string]int, error) {
 	limits := make(map[string]int, len(pairs))
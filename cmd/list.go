@@ -1,15 +1,20 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
@@ -18,28 +23,74 @@ var listCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List tasks",
-	Long:    `Lists tasks with optional filtering, sorting, and output format control.`,
-	RunE:    runList,
+	Long: `Lists tasks with optional filtering, sorting, and output format control.
+
+The defaults.list config section supplies --sort, --reverse and --limit
+when those flags are not given, and hides defaults.list.exclude_statuses
+unless --status is given.`,
+	RunE: runList,
 }
 
 func init() {
 	listCmd.Flags().StringSlice("status", nil, "filter by status (comma-separated)")
 	listCmd.Flags().StringSlice("priority", nil, "filter by priority (comma-separated)")
 	listCmd.Flags().String("assignee", "", "filter by assignee")
-	listCmd.Flags().String("tag", "", "filter by tag")
-	listCmd.Flags().String("sort", "id", "sort field (id, status, priority, created, updated, due)")
+	listCmd.Flags().Bool("unassigned", false, "show only tasks without an assignee")
+	listCmd.Flags().StringSlice("tag", nil, "filter by tag (repeatable; tasks must have all)")
+	listCmd.Flags().StringSlice("any-tag", nil, "filter to tasks with at least one of these tags")
+	listCmd.Flags().StringSlice("exclude-tag", nil, "exclude tasks with any of these tags")
+	listCmd.Flags().Bool("no-tags", false, "only show tasks without tags")
+	listCmd.MarkFlagsMutuallyExclusive("no-tags", "tag")
+	listCmd.MarkFlagsMutuallyExclusive("no-tags", "any-tag")
+	listCmd.Flags().String("sort", "id", "sort fields, comma-separated, \"-\" prefix for descending (id, status, priority, created, updated, due)")
 	listCmd.Flags().BoolP("reverse", "r", false, "reverse sort order")
 	listCmd.Flags().IntP("limit", "n", 0, "limit number of results")
+	listCmd.Flags().Int("offset", 0, "skip the first N results (applied after sorting, before --limit)")
 	listCmd.Flags().Bool("blocked", false, "show only blocked tasks")
 	listCmd.Flags().Bool("not-blocked", false, "show only non-blocked tasks")
-	listCmd.Flags().Int("parent", 0, "filter by parent task ID")
+	listCmd.Flags().Int("parent", 0, "filter by parent task ID (alias --children)")
+	listCmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == "children" {
+			name = "parent"
+		}
+		return pflag.NormalizedName(name)
+	})
+	listCmd.Flags().Int("depends-on", 0, "show only tasks that depend on task ID")
+	listCmd.Flags().Int("blocks", 0, "show only tasks that task ID depends on")
 	listCmd.Flags().Bool("unblocked", false, "show only tasks with all dependencies satisfied (missing dependency IDs are treated as satisfied)")
 	listCmd.Flags().Bool("unclaimed", false, "show only unclaimed or expired-claim tasks")
 	listCmd.Flags().String("claimed-by", "", "filter by claimant")
 	listCmd.Flags().String("class", "", "filter by class of service")
-	listCmd.Flags().StringP("search", "s", "", "search tasks by title, body, or tags (case-insensitive)")
-	listCmd.Flags().Bool("archived", false, "show only archived tasks")
+	listCmd.Flags().StringP("search", "s", "", "search tasks by title, body, or tags (case-insensitive); scope with title:, body:, tag:")
+	listCmd.Flags().String("search-regex", "", "filter tasks whose title, body, or tags match a regular expression")
+	listCmd.Flags().Bool("case-sensitive", false, "make --search and --search-regex case-sensitive")
+	addArchivedFlags(listCmd)
+	listCmd.Flags().String("created-since", "", "only tasks created since DATE or DURATION ago (e.g. 2026-01-31, 7d)")
+	listCmd.Flags().String("created-until", "", "only tasks created before DATE (inclusive) or DURATION ago")
+	listCmd.Flags().String("updated-since", "", "only tasks updated since DATE or DURATION ago (e.g. 24h)")
+	listCmd.Flags().String("updated-until", "", "only tasks updated before DATE (inclusive) or DURATION ago")
+	listCmd.Flags().Bool("overdue", false, "show only tasks past their due date (excludes done tasks)")
+	listCmd.Flags().String("due-before", "", "only tasks due before DATE (YYYY-MM-DD)")
+	listCmd.Flags().String("due-after", "", "only tasks due after DATE (YYYY-MM-DD)")
+	listCmd.Flags().Bool("has-due", false, "show only tasks with a due date")
+	listCmd.Flags().Bool("no-due", false, "show only tasks without a due date")
+	listCmd.Flags().StringSlice("has-link", nil, "show only tasks with a link of each REL (e.g. pr)")
+	listCmd.Flags().StringSlice("no-link", nil, "show only tasks without a link of any REL")
+	listCmd.Flags().StringSlice("fields", nil, "columns to show ("+strings.Join(output.TaskFields(), ", ")+")")
 	listCmd.Flags().String("group-by", "", "group results by field ("+strings.Join(board.ValidGroupByFields(), ", ")+")")
+	listCmd.Flags().Bool("count", false, "print only the number of matching tasks")
+	listCmd.Flags().Bool("fail-if-empty", false, "exit 1 if no tasks match")
+	listCmd.Flags().Bool("fail-if-any", false, "exit 1 if any tasks match")
+	listCmd.MarkFlagsMutuallyExclusive("count", "group-by")
+	listCmd.MarkFlagsMutuallyExclusive("fail-if-empty", "fail-if-any")
+	_ = listCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	_ = listCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = listCmd.RegisterFlagCompletionFunc("class", completeClasses)
+	_ = listCmd.RegisterFlagCompletionFunc("sort", completeSortFields)
+	_ = listCmd.RegisterFlagCompletionFunc("group-by", completeGroupBy)
+	_ = listCmd.RegisterFlagCompletionFunc("fields", completeTaskFields)
+	_ = listCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
+	_ = listCmd.RegisterFlagCompletionFunc("claimed-by", completeClaimants)
 	rootCmd.AddCommand(listCmd)
 }
 
@@ -52,10 +103,17 @@ func runList(cmd *cobra.Command, _ []string) error {
 	statuses, _ := cmd.Flags().GetStringSlice("status")
 	priorities, _ := cmd.Flags().GetStringSlice("priority")
 	assignee, _ := cmd.Flags().GetString("assignee")
-	tag, _ := cmd.Flags().GetString("tag")
+	unassigned, _ := cmd.Flags().GetBool("unassigned")
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	anyTags, _ := cmd.Flags().GetStringSlice("any-tag")
+	excludeTags, _ := cmd.Flags().GetStringSlice("exclude-tag")
+	noTags, _ := cmd.Flags().GetBool("no-tags")
+	hasLinks, _ := cmd.Flags().GetStringSlice("has-link")
+	noLinks, _ := cmd.Flags().GetStringSlice("no-link")
 	sortBy, _ := cmd.Flags().GetString("sort")
 	reverse, _ := cmd.Flags().GetBool("reverse")
 	limit, _ := cmd.Flags().GetInt("limit")
+	offset, _ := cmd.Flags().GetInt("offset")
 	blocked, _ := cmd.Flags().GetBool("blocked")
 	notBlocked, _ := cmd.Flags().GetBool("not-blocked")
 	parentID, _ := cmd.Flags().GetInt("parent")
@@ -65,28 +123,68 @@ func runList(cmd *cobra.Command, _ []string) error {
 	class, _ := cmd.Flags().GetString("class")
 	search, _ := cmd.Flags().GetString("search")
 	groupBy, _ := cmd.Flags().GetString("group-by")
-	archived, _ := cmd.Flags().GetBool("archived")
 
 	if groupBy != "" && !slices.Contains(board.ValidGroupByFields(), groupBy) {
 		return clierr.Newf(clierr.InvalidGroupBy, "invalid --group-by field %q; valid: %s",
 			groupBy, strings.Join(board.ValidGroupByFields(), ", "))
 	}
+	if assignee != "" && unassigned {
+		return clierr.New(clierr.InvalidInput, "cannot use --assignee and --unassigned together")
+	}
+	if offset < 0 {
+		return clierr.Newf(clierr.InvalidInput, "--offset must not be negative, got %d", offset)
+	}
+	fields, _ := cmd.Flags().GetStringSlice("fields")
+	for _, f := range fields {
+		if !slices.Contains(output.TaskFields(), f) {
+			return clierr.Newf(clierr.InvalidInput, "invalid --fields field %q; valid: %s",
+				f, strings.Join(output.TaskFields(), ", "))
+		}
+	}
+
+	applyListDefaults(cmd, cfg, &sortBy, &reverse, &limit)
+	// Validate after defaults so a bad defaults.list.sort is reported too.
+	if _, err := board.ParseSortKeys(sortBy); err != nil {
+		return err
+	}
+
+	// Map case variants and aliases to canonical names; unknown statuses
+	// are kept and simply match nothing.
+	for i, s := range statuses {
+		if canonical, ok := cfg.ResolveStatus(s); ok {
+			statuses[i] = canonical
+		}
+	}
 
 	filter := board.FilterOptions{
 		Statuses:     statuses,
 		Priorities:   priorities,
 		Assignee:     assignee,
-		Tag:          tag,
+		Unassigned:   unassigned,
+		Tags:         tags,
+		AnyTags:      anyTags,
+		ExcludeTags:  excludeTags,
+		NoTags:       noTags,
+		HasLinks:     hasLinks,
+		NoLinks:      noLinks,
 		Search:       search,
 		ClaimTimeout: cfg.ClaimTimeoutDuration(),
 	}
 
-	// --archived flag: show only archived tasks.
-	// Default (no --status, no --archived): exclude archived.
-	if archived {
-		filter.Statuses = []string{config.ArchivedStatus}
-	} else if !cmd.Flags().Changed("status") {
-		filter.ExcludeStatuses = []string{config.ArchivedStatus}
+	mode := archivedMode(cmd)
+	if len(statuses) == 0 {
+		for _, s := range cfg.Defaults.List.ExcludeStatuses {
+			// --archived / --include-archived override an excluded archived status.
+			if cfg.IsArchivedStatus(s) && mode != board.ArchivedDefault {
+				continue
+			}
+			filter.ExcludeStatuses = append(filter.ExcludeStatuses, s)
+		}
+	}
+	board.ApplyArchived(&filter, mode)
+
+	if err := applySearchFlags(cmd, &filter); err != nil {
+		return err
 	}
 
 	if unclaimed {
@@ -110,11 +208,22 @@ func runList(cmd *cobra.Command, _ []string) error {
 	if cmd.Flags().Changed("parent") {
 		filter.ParentID = &parentID
 	}
+	if err := applyDependencyFilters(cmd, &filter, cfg); err != nil {
+		return err
+	}
+
+	if err := applyTimeFilters(cmd, &filter, time.Now()); err != nil {
+		return err
+	}
+	if err := applyDueFilters(cmd, &filter, cfg); err != nil {
+		return err
+	}
 
 	opts := board.ListOptions{
 		Filter:    filter,
 		SortBy:    sortBy,
 		Reverse:   reverse,
+		Offset:    offset,
 		Limit:     limit,
 		Unblocked: unblocked,
 	}
@@ -125,15 +234,157 @@ func runList(cmd *cobra.Command, _ []string) error {
 	}
 	printWarnings(warnings)
 
-	if groupBy != "" {
-		return outputGroupedList(tasks, groupBy, cfg)
+	count, _ := cmd.Flags().GetBool("count")
+	switch {
+	case count:
+		err = outputListCount(len(tasks))
+	case groupBy != "":
+		err = outputGroupedList(tasks, groupBy, cfg)
+	default:
+		err = outputTaskList(tasks, fields)
+	}
+	if err != nil {
+		return err
 	}
 
-	return outputTaskList(tasks)
+	return checkListGate(cmd, len(tasks))
+}
+
+// applyListDefaults fills sort, reverse and limit from defaults.list when the
+// corresponding flags were not given. The default reverse only accompanies
+// the default sort, and the default limit is skipped for --count so the
+// count covers every match.
+func applyListDefaults(cmd *cobra.Command, cfg *config.Config, sortBy *string, reverse *bool, limit *int) {
+	d := cfg.Defaults.List
+	if !cmd.Flags().Changed("sort") && d.Sort != "" {
+		*sortBy = d.Sort
+		if !cmd.Flags().Changed("reverse") {
+			*reverse = d.Reverse
+		}
+	}
+	count, _ := cmd.Flags().GetBool("count")
+	if !cmd.Flags().Changed("limit") && !count {
+		*limit = d.Limit
+	}
+}
+
+func outputListCount(n int) error {
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, map[string]int{"count": n})
+	}
+	fmt.Fprintln(os.Stdout, n)
+	return nil
+}
+
+// checkListGate applies --fail-if-empty and --fail-if-any to the number of
+// listed tasks, exiting 1 without further output when the condition holds.
+func checkListGate(cmd *cobra.Command, n int) error {
+	failIfEmpty, _ := cmd.Flags().GetBool("fail-if-empty")
+	failIfAny, _ := cmd.Flags().GetBool("fail-if-any")
+	if (failIfEmpty && n == 0) || (failIfAny && n > 0) {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
+
+// applySearchFlags compiles --search-regex and applies --case-sensitive.
+func applySearchFlags(cmd *cobra.Command, filter *board.FilterOptions) error {
+	caseSensitive, _ := cmd.Flags().GetBool("case-sensitive")
+	filter.CaseSensitive = caseSensitive
+
+	pattern, _ := cmd.Flags().GetString("search-regex")
+	if pattern == "" {
+		return nil
+	}
+	if !caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return clierr.Newf(clierr.InvalidInput, "invalid --search-regex: %v", err).
+			WithDetails(map[string]any{"flag": "search-regex", "input": pattern})
+	}
+	filter.SearchRegex = re
+	return nil
+}
+
+// applyTimeFilters parses the --created-*/--updated-* flags into filter.
+func applyTimeFilters(cmd *cobra.Command, filter *board.FilterOptions, now time.Time) error {
+	bounds := []struct {
+		flag  string
+		until bool
+		dst   **time.Time
+	}{
+		{"created-since", false, &filter.CreatedSince},
+		{"created-until", true, &filter.CreatedUntil},
+		{"updated-since", false, &filter.UpdatedSince},
+		{"updated-until", true, &filter.UpdatedUntil},
+	}
+	for _, b := range bounds {
+		v, _ := cmd.Flags().GetString(b.flag)
+		if v == "" {
+			continue
+		}
+		ts, err := parseTimeBound(v, now, b.until)
+		if err != nil {
+			return clierr.Newf(clierr.InvalidInput, "invalid --%s: %v", b.flag, err).
+				WithDetails(map[string]any{"flag": b.flag, "input": v})
+		}
+		*b.dst = &ts
+	}
+	return nil
+}
+
+// applyDueFilters parses the --overdue and --due-* flags into filter.
+func applyDueFilters(cmd *cobra.Command, filter *board.FilterOptions, cfg *config.Config) error {
+	if overdue, _ := cmd.Flags().GetBool("overdue"); overdue {
+		filter.Overdue = true
+		filter.TerminalStatuses = cfg.TerminalStatuses()
+	}
+	hasDue, _ := cmd.Flags().GetBool("has-due")
+	noDue, _ := cmd.Flags().GetBool("no-due")
+	switch {
+	case hasDue && noDue:
+		return clierr.New(clierr.InvalidInput, "cannot use --has-due and --no-due together")
+	case hasDue, noDue:
+		filter.HasDue = &hasDue
+	}
+	bounds := []struct {
+		flag string
+		dst  **date.Date
+	}{
+		{"due-before", &filter.DueBefore},
+		{"due-after", &filter.DueAfter},
+	}
+	for _, b := range bounds {
+		v, _ := cmd.Flags().GetString(b.flag)
+		if v == "" {
+			continue
+		}
+		d, err := date.Parse(v)
+		if err != nil {
+			return clierr.Newf(clierr.InvalidInput, "invalid --%s: %v", b.flag, err).
+				WithDetails(map[string]any{"flag": b.flag, "input": v})
+		}
+		*b.dst = &d
+	}
+	return nil
+}
+
+// parseTimeBound resolves a date or relative duration to a time bound.
+// Dates used as an upper bound include the whole day.
+func parseTimeBound(v string, now time.Time, until bool) (time.Time, error) {
+	if d, err := date.Parse(v); err == nil {
+		if until {
+			return d.AddDate(0, 0, 1), nil
+		}
+		return d.Time, nil
+	}
+	return date.ParseSince(v, now)
 }
 
 func outputGroupedList(tasks []*task.Task, groupBy string, cfg *config.Config) error {
-	grouped := board.GroupBy(tasks, groupBy, cfg)
+	grouped := board.GroupBy(tasks, groupLookup(cfg, groupBy), groupBy, cfg)
 	if outputFormat() == output.FormatJSON {
 		return output.JSON(os.Stdout, grouped)
 	}
@@ -141,7 +392,40 @@ func outputGroupedList(tasks []*task.Task, groupBy string, cfg *config.Config) e
 	return nil
 }
 
-func outputTaskList(tasks []*task.Task) error {
+// applyDependencyFilters parses --depends-on and --blocks into filter. The
+// tasks --blocks ID matches are the dependencies of task ID.
+func applyDependencyFilters(cmd *cobra.Command, filter *board.FilterOptions, cfg *config.Config) error {
+	if cmd.Flags().Changed("depends-on") {
+		id, _ := cmd.Flags().GetInt("depends-on")
+		filter.DependsOnID = &id
+	}
+	if cmd.Flags().Changed("blocks") {
+		id, _ := cmd.Flags().GetInt("blocks")
+		t, err := readTaskByID(cfg, id)
+		if err != nil {
+			return err
+		}
+		filter.IDs = append([]int{}, t.DependsOn...)
+	}
+	return nil
+}
+
+// groupLookup returns every task, archived included, when grouping by parent
+// needs to name parents outside the listed tasks; otherwise nil.
+func groupLookup(cfg *config.Config, groupBy string) []*task.Task {
+	if groupBy != "parent" {
+		return nil
+	}
+	all, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return nil // fall back to naming parents from the listed tasks
+	}
+	return all
+}
+
+// outputTaskList renders tasks; fields selects table and compact columns
+// (nil for the defaults) and does not affect JSON or CSV.
+func outputTaskList(tasks []*task.Task, fields []string) error {
 	format := outputFormat()
 	if format == output.FormatJSON {
 		if tasks == nil {
@@ -150,10 +434,23 @@ func outputTaskList(tasks []*task.Task) error {
 		return output.JSON(os.Stdout, tasks)
 	}
 	if format == output.FormatCompact {
-		output.TaskCompact(os.Stdout, tasks)
+		if len(fields) > 0 {
+			output.TaskCompactFields(os.Stdout, tasks, fields)
+		} else {
+			output.TaskCompact(os.Stdout, tasks)
+		}
 		return nil
 	}
+	if format == output.FormatCSV {
+		return output.TaskCSV(os.Stdout, tasks)
+	}
+	if format == output.FormatJSONL {
+		return output.TaskJSONL(os.Stdout, tasks)
+	}
 
-	output.TaskTable(os.Stdout, tasks)
+	if len(fields) == 0 {
+		fields = output.DefaultTaskFields
+	}
+	output.TaskTableFields(os.Stdout, tasks, fields)
 	return nil
 }
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// cliEnv makes the test binary act as the agentwatch CLI, so tests can run
+// real commands in separate processes.
+const cliEnv = "AGENTWATCH_TEST_CLI"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(cliEnv) == "1" {
+		rootCmd.SetArgs(os.Args[1:])
+		Execute()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runCLI runs agentwatch with args against the board in root in a separate
+// process and returns its combined output.
+func runCLI(t *testing.T, root string, args ...string) ([]byte, error) {
+	t.Helper()
+	return runCLIInput(t, root, "", args...)
+}
+
+// runCLIInput is runCLI with stdin.
+func runCLIInput(t *testing.T, root, stdin string, args ...string) ([]byte, error) {
+	t.Helper()
+	c := exec.Command(os.Args[0], append([]string{"--dir", root}, args...)...) //nolint:gosec // test binary
+	c.Env = append(os.Environ(), cliEnv+"=1", "AGENTWATCH_AGENT=test")
+	c.Stdin = strings.NewReader(stdin)
+	return c.CombinedOutput()
+}
+
+// newTestBoard creates a board under a temporary root, lets setup adjust the
+// config, and writes one task per title in the default status. Returns the
+// root (for --dir) and the loaded config.
+func newTestBoard(t *testing.T, setup func(*config.Config), titles ...string) (string, *config.Config) {
+	t.Helper()
+	// Keep the developer's own defaults.yml out of the tests; runCLI
+	// inherits the variable.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+	cfg, err := config.Init(filepath.Join(root, ".agents", "agentwatch"), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if setup != nil {
+		setup(cfg)
+	}
+	for _, title := range titles {
+		tk := &task.Task{
+			ID: cfg.NextID, Title: title,
+			Status: cfg.Defaults.Status, Priority: cfg.Defaults.Priority, Class: cfg.Defaults.Class,
+		}
+		if _, err := writeNewTask(cfg, tk); err != nil {
+			t.Fatal(err)
+		}
+		cfg.NextID++
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	return root, cfg
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -15,33 +16,103 @@ import (
 )
 
 var moveCmd = &cobra.Command{
-	Use:   "move ID[,ID,...] [STATUS]",
+	Use:   "move {ID[,ID,...] | --where FILTER} [STATUS]",
 	Short: "Move a task to a different status",
 	Long: `Changes the status of a task. Provide the new status directly,
 or use --next/--prev to move along the configured status order.
-Multiple IDs can be provided as a comma-separated list.`,
-	Args: cobra.RangeArgs(1, 2), //nolint:mnd // 1 or 2 positional args
-	RunE: runMove,
+Multiple IDs can be provided as a comma-separated list, or pass "-" to read
+IDs separated by whitespace, commas or newlines from stdin.
+
+Use --dry-run to check a move without writing: the target status, claim and
+WIP constraints are resolved and the outcome is printed. The exit code is 1
+if any task could not be moved.
+
+Use --all-in STATUS with --to STATUS (or --next/--prev) to move every task
+currently in a status, e.g. "move --all-in review --to done". Combine with
+--dry-run to list the tasks that would move.
+
+Use --where to move every task matching a filter, e.g.
+"move --where status=todo,tag=frontend backlog". The matched count is
+confirmed interactively unless --yes is given, and --max caps the matches.
+
+Use --top, --bottom, --before ID or --after ID to reorder a task within its
+column (after moving it, if a status is given). Tasks of equal priority are
+listed in this order; unranked tasks come after ranked ones.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("all-in") {
+			return cobra.NoArgs(cmd, args)
+		}
+		if cmd.Flags().Changed("where") {
+			return cobra.MaximumNArgs(1)(cmd, args)
+		}
+		return cobra.RangeArgs(1, 2)(cmd, args) //nolint:mnd // 1 or 2 positional args
+	},
+	RunE:              runMove,
+	ValidArgsFunction: completeMoveArgs,
 }
 
 func init() {
 	moveCmd.Flags().Bool("next", false, "move to next status")
 	moveCmd.Flags().Bool("prev", false, "move to previous status")
 	moveCmd.Flags().String("claim", "", "claim task for an agent during move")
+	moveCmd.Flags().String("reason", "", "why the task is moving (recorded in the activity log)")
+	moveCmd.Flags().String("all-in", "", "move all tasks currently in this status")
+	moveCmd.Flags().String("to", "", "target status for --all-in")
+	moveCmd.Flags().Bool("dry-run", false, "show what would happen without writing")
+	moveCmd.Flags().Bool("top", false, "place the task first in its column")
+	moveCmd.Flags().Bool("bottom", false, "place the task last in its column")
+	moveCmd.Flags().Int("before", 0, "place the task just before task ID in its column")
+	moveCmd.Flags().Int("after", 0, "place the task just after task ID in its column")
+	addWhereFlags(moveCmd)
+	moveCmd.MarkFlagsMutuallyExclusive(placementFlags...)
+	for _, name := range placementFlags {
+		moveCmd.MarkFlagsMutuallyExclusive(name, "all-in")
+		moveCmd.MarkFlagsMutuallyExclusive(name, "where")
+		moveCmd.MarkFlagsMutuallyExclusive(name, "dry-run")
+	}
+	moveCmd.MarkFlagsMutuallyExclusive("all-in", "where")
+	moveCmd.MarkFlagsMutuallyExclusive("where", "dry-run")
+	_ = moveCmd.RegisterFlagCompletionFunc("all-in", completeStatuses)
+	_ = moveCmd.RegisterFlagCompletionFunc("to", completeStatuses)
 	rootCmd.AddCommand(moveCmd)
 }
 
 func runMove(cmd *cobra.Command, args []string) error {
-	ids, err := parseIDs(args[0])
+	if cmd.Flags().Changed("all-in") {
+		return runMoveAllIn(cmd)
+	}
+	if cmd.Flags().Changed("where") {
+		return runMoveWhere(cmd, args)
+	}
+
+	cfg, err := loadConfig()
 	if err != nil {
 		return err
 	}
 
-	cfg, err := loadConfig()
+	var ids []int
+	if args[0] == stdinIDsArg {
+		ids, err = readStdinIDs(os.Stdin)
+	} else {
+		ids, err = parseIDs(args[0])
+	}
 	if err != nil {
 		return err
 	}
 
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		return runMoveProbe(cfg, ids, cmd, args)
+	}
+	if hasPlacement(cmd) {
+		return runMoveRank(cfg, ids, cmd, args)
+	}
+	if args[0] == stdinIDsArg {
+		return runBatch(ids, func(id int) error {
+			_, _, err := executeMove(cfg, id, cmd, args)
+			return err
+		})
+	}
+
 	// Single ID: preserve exact current behavior.
 	if len(ids) == 1 {
 		return moveSingleTask(cfg, ids[0], cmd, args)
@@ -54,6 +125,217 @@ func runMove(cmd *cobra.Command, args []string) error {
 	})
 }
 
+// runMoveAllIn moves every task in the --all-in status through executeMove,
+// reporting per-task results via runBatch.
+func runMoveAllIn(cmd *cobra.Command) error {
+	from, _ := cmd.Flags().GetString("all-in")
+	to, _ := cmd.Flags().GetString("to")
+	next, _ := cmd.Flags().GetBool("next")
+	prev, _ := cmd.Flags().GetBool("prev")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if to == "" && !next && !prev {
+		return clierr.New(clierr.InvalidInput, "provide a target with --to STATUS or use --next/--prev")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if from, err = task.ResolveStatus(cfg, from); err != nil {
+		return err
+	}
+
+	// executeMove resolves the target from positional args; --to stands in
+	// for the STATUS argument.
+	moveArgs := []string{from}
+	if to != "" {
+		if to, err = task.ResolveStatus(cfg, to); err != nil {
+			return err
+		}
+		moveArgs = append(moveArgs, to)
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+	tasks = board.Filter(tasks, board.FilterOptions{Statuses: []string{from}})
+	board.SortByID(tasks)
+
+	if dryRun {
+		return outputMoveDryRun(tasks, from)
+	}
+	if len(tasks) == 0 && outputFormat() != output.FormatJSON {
+		output.Messagef(os.Stdout, "No tasks in %s", from)
+		return nil
+	}
+
+	ids := make([]int, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+	return runBatch(ids, func(id int) error {
+		_, _, err := executeMove(cfg, id, cmd, moveArgs)
+		return err
+	})
+}
+
+// runMoveWhere moves every task matching --where to the STATUS argument (or
+// along --next/--prev).
+func runMoveWhere(cmd *cobra.Command, args []string) error {
+	next, _ := cmd.Flags().GetBool("next")
+	prev, _ := cmd.Flags().GetBool("prev")
+	if len(args) == 0 && !next && !prev {
+		return clierr.New(clierr.InvalidInput, "provide a target status or use --next/--prev")
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// executeMove reads the target from the second positional argument.
+	moveArgs := []string{""}
+	if len(args) == 1 {
+		status, err := task.ResolveStatus(cfg, args[0])
+		if err != nil {
+			return err
+		}
+		moveArgs = append(moveArgs, status)
+	}
+
+	return runWhereBatch(cmd, cfg, "Move", func(id int) error {
+		_, _, err := executeMove(cfg, id, cmd, moveArgs)
+		return err
+	})
+}
+
+// outputMoveDryRun lists the tasks an --all-in move would affect.
+func outputMoveDryRun(tasks []*task.Task, from string) error {
+	switch outputFormat() {
+	case output.FormatJSON:
+		return output.JSON(os.Stdout, tasks)
+	case output.FormatCompact:
+		output.TaskCompact(os.Stdout, tasks)
+	case output.FormatCSV:
+		return output.TaskCSV(os.Stdout, tasks)
+	default:
+		output.TaskTable(os.Stdout, tasks)
+	}
+	output.Messagef(os.Stdout, "Dry run: would move %d tasks from %s", len(tasks), from)
+	return nil
+}
+
+// moveProbe is the outcome of move --dry-run for one task.
+type moveProbe struct {
+	ID       int    `json:"id"`
+	From     string `json:"from,omitempty"`
+	To       string `json:"to,omitempty"`
+	Allowed  bool   `json:"allowed"`
+	Reason   string `json:"reason,omitempty"`
+	WIPCount int    `json:"wip_count,omitempty"` // tasks in To after the move
+	WIPLimit int    `json:"wip_limit,omitempty"`
+}
+
+// runMoveProbe reports what moving ids would do without writing. A single ID
+// prints one result; failures to resolve the task or its target are errors.
+func runMoveProbe(cfg *config.Config, ids []int, cmd *cobra.Command, args []string) error {
+	probes := make([]moveProbe, 0, len(ids))
+	anyBlocked := false
+	for _, id := range ids {
+		p, err := probeMove(cfg, id, cmd, args)
+		if err != nil {
+			if len(ids) == 1 {
+				return err
+			}
+			p = moveProbe{ID: id, Reason: err.Error()}
+		}
+		anyBlocked = anyBlocked || !p.Allowed
+		probes = append(probes, p)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		var data any = probes
+		if len(probes) == 1 {
+			data = probes[0]
+		}
+		if err := output.JSON(os.Stdout, data); err != nil {
+			return err
+		}
+	} else {
+		for _, p := range probes {
+			fmt.Fprintln(os.Stdout, formatMoveProbe(p))
+		}
+	}
+
+	if anyBlocked {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
+
+// probeMove runs the checks of moveTask against task id without writing.
+// Claim and WIP violations are reported as a disallowed probe.
+func probeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string) (moveProbe, error) {
+	t, err := readTaskByID(cfg, id)
+	if err != nil {
+		return moveProbe{}, err
+	}
+	newStatus, err := resolveTargetStatus(cmd, args, t, cfg)
+	if err != nil {
+		return moveProbe{}, err
+	}
+
+	p := moveProbe{ID: id, From: t.Status, To: newStatus, Allowed: true}
+	if t.Status == newStatus {
+		return p, nil
+	}
+	if limit := cfg.WIPLimit(newStatus); limit > 0 {
+		tasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+		if err != nil {
+			return moveProbe{}, err
+		}
+		p.WIPCount, p.WIPLimit = board.CountByStatus(tasks)[newStatus]+1, limit
+	}
+
+	claimant, _ := cmd.Flags().GetString("claim")
+	err = validateMoveClaim(cfg, t, claimant)
+	if err == nil && cfg.StatusRequiresClaim(newStatus) && claimant == "" {
+		err = task.ValidateClaimRequired(newStatus)
+	}
+	if err == nil {
+		err = enforceMoveWIP(cfg, t, newStatus)
+	}
+	if err != nil {
+		var cliErr *clierr.Error
+		if !errors.As(err, &cliErr) {
+			return moveProbe{}, err
+		}
+		p.Allowed, p.Reason = false, cliErr.Message
+	}
+	return p, nil
+}
+
+// formatMoveProbe renders a dry-run result as one line.
+func formatMoveProbe(p moveProbe) string {
+	if p.From == "" {
+		return fmt.Sprintf("Dry run: cannot move #%d: %s", p.ID, p.Reason)
+	}
+	if p.From == p.To {
+		return fmt.Sprintf("Dry run: #%d is already in %s", p.ID, p.To)
+	}
+	line := fmt.Sprintf("#%d %s -> %s", p.ID, p.From, p.To)
+	if p.WIPLimit > 0 {
+		line += fmt.Sprintf(" (WIP would be %d/%d)", p.WIPCount, p.WIPLimit)
+	}
+	if !p.Allowed {
+		return "Dry run: cannot move " + line + ": " + p.Reason
+	}
+	return "Dry run: would move " + line
+}
+
 // moveResult wraps a task with a changed flag for JSON output.
 type moveResult struct {
 	*task.Task
@@ -80,10 +362,25 @@ func moveSingleTask(cfg *config.Config, id int, cmd *cobra.Command, args []strin
 	return nil
 }
 
-// executeMove performs the core move: find, read, resolve, wip check, write, log.
-// Returns (task, oldStatus, error). If the task was already at the target status
-// (idempotent), oldStatus is empty and the task is returned unchanged.
+// executeMove performs the core move under the board lock: find, read,
+// resolve, wip check, write, log. Returns (task, oldStatus, error). If the task
+// was already at the target status (idempotent), oldStatus is empty and the
+// task is returned unchanged.
 func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string) (*task.Task, string, error) {
+	var (
+		t         *task.Task
+		oldStatus string
+	)
+	err := withBoardLock(cfg, func() error {
+		var err error
+		t, oldStatus, err = moveTask(cfg, id, cmd, args)
+		return err
+	})
+	return t, oldStatus, err
+}
+
+// moveTask is executeMove without the lock.
+func moveTask(cfg *config.Config, id int, cmd *cobra.Command, args []string) (*task.Task, string, error) {
 	path, err := task.FindByID(cfg.TasksPath(), id)
 	if err != nil {
 		return nil, "", err
@@ -95,9 +392,13 @@ func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string)
 	}
 
 	claimant, _ := cmd.Flags().GetString("claim")
+	if cmd.Flags().Changed("claim") && claimant == "" {
+		return nil, "", clierr.New(clierr.InvalidInput, "claim name is required (use --claim NAME)")
+	}
 	if err = validateMoveClaim(cfg, t, claimant); err != nil {
 		return nil, "", err
 	}
+	setActor(claimant)
 
 	newStatus, err := resolveTargetStatus(cmd, args, t, cfg)
 	if err != nil {
@@ -126,6 +427,7 @@ func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string)
 	oldStatus := t.Status
 	t.Status = newStatus
 	task.UpdateTimestamps(t, oldStatus, newStatus, cfg)
+	recordTransition(cfg, t, oldStatus, newStatus)
 	applyMoveClaim(cmd, t, claimant)
 	t.Updated = time.Now()
 
@@ -133,7 +435,8 @@ func executeMove(cfg *config.Config, id int, cmd *cobra.Command, args []string)
 		return nil, "", fmt.Errorf("writing task: %w", err)
 	}
 
-	logActivity(cfg, "move", id, oldStatus+" -> "+newStatus)
+	reason, _ := cmd.Flags().GetString("reason")
+	logActivityWithReason(cfg, "move", id, board.DetailWithReason(oldStatus+" -> "+newStatus, reason), reason)
 	return t, oldStatus, nil
 }
 
@@ -142,17 +445,14 @@ func validateMoveClaim(cfg *config.Config, t *task.Task, claimant string) error
 	return checkClaim(t, claimant, cfg.ClaimTimeoutDuration())
 }
 
-// enforceMoveWIP checks WIP limits, considering class of service.
+// enforceMoveWIP checks WIP limits, considering class of service and priority.
 func enforceMoveWIP(cfg *config.Config, t *task.Task, newStatus string) error {
-	if t.Class != "" && len(cfg.Classes) > 0 {
-		return enforceWIPLimitForClass(cfg, t, t.Status, newStatus)
-	}
-	return enforceWIPLimit(cfg, t.Status, newStatus)
+	return board.EnforceWIP(cfg, t, t.Status, newStatus)
 }
 
 // applyMoveClaim sets the claim on the task if --claim flag was provided.
 func applyMoveClaim(cmd *cobra.Command, t *task.Task, claimant string) {
-	if cmd.Flags().Changed("claim") && claimant != "" {
+	if cmd.Flags().Changed("claim") {
 		now := time.Now()
 		t.ClaimedBy = claimant
 		t.ClaimedAt = &now
@@ -165,11 +465,7 @@ func resolveTargetStatus(cmd *cobra.Command, args []string, t *task.Task, cfg *c
 
 	switch {
 	case len(args) == 2: //nolint:mnd // positional arg
-		status := args[1]
-		if err := task.ValidateStatus(status, cfg.StatusNames()); err != nil {
-			return "", err
-		}
-		return status, nil
+		return task.ResolveStatus(cfg, args[1])
 	case next:
 		names := cfg.StatusNames()
 		idx := cfg.StatusIndex(t.Status)
@@ -189,59 +485,6 @@ func resolveTargetStatus(cmd *cobra.Command, args []string, t *task.Task, cfg *c
 	}
 }
 
-// enforceWIPLimit checks if the target status has room.
-func enforceWIPLimit(cfg *config.Config, currentStatus, targetStatus string) error {
-	limit := cfg.WIPLimit(targetStatus)
-	if limit == 0 {
-		return nil
-	}
-
-	allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
-	if err != nil {
-		return fmt.Errorf("reading tasks for WIP check: %w", err)
-	}
-
-	counts := board.CountByStatus(allTasks)
-	return checkWIPLimit(cfg, counts, targetStatus, currentStatus)
-}
-
-// enforceWIPLimitForClass checks WIP limits considering class of service.
-// Expedite tasks bypass column WIP limits but have their own board-wide limit.
-func enforceWIPLimitForClass(cfg *config.Config, t *task.Task, currentStatus, targetStatus string) error {
-	classConf := cfg.ClassByName(t.Class)
-
-	// Check class-level board-wide WIP limit.
-	if classConf != nil && classConf.WIPLimit > 0 {
-		allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
-		if err != nil {
-			return fmt.Errorf("reading tasks for class WIP check: %w", err)
-		}
-		count := countByClass(allTasks, t.Class, t.ID)
-		if count >= classConf.WIPLimit {
-			return task.ValidateClassWIPExceeded(t.Class, classConf.WIPLimit, count)
-		}
-	}
-
-	// If class bypasses column WIP, skip column check.
-	if classConf != nil && classConf.BypassColumnWIP {
-		return nil
-	}
-
-	// Normal column WIP check.
-	return enforceWIPLimit(cfg, currentStatus, targetStatus)
-}
-
-// countByClass counts tasks with a given class, excluding a specific task ID.
-func countByClass(tasks []*task.Task, class string, excludeID int) int {
-	count := 0
-	for _, t := range tasks {
-		if t.Class == class && t.ID != excludeID {
-			count++
-		}
-	}
-	return count
-}
-
 func outputMoveResult(t *task.Task, changed bool) error {
 	format := outputFormat()
 	if format == output.FormatJSON {
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// placementFlags are the move flags that reorder a task within its column.
+var placementFlags = []string{"top", "bottom", "before", "after"}
+
+// hasPlacement reports whether any placement flag was given.
+func hasPlacement(cmd *cobra.Command) bool {
+	for _, name := range placementFlags {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// placementFromFlags returns the requested placement, its anchor task ID for
+// --before/--after, and a description ("before #3 in") that is followed by
+// the status in messages and the activity log.
+func placementFromFlags(cmd *cobra.Command) (board.Placement, int, string) {
+	if id, _ := cmd.Flags().GetInt("before"); cmd.Flags().Changed("before") {
+		return board.PlaceBefore, id, fmt.Sprintf("before #%d in", id)
+	}
+	if id, _ := cmd.Flags().GetInt("after"); cmd.Flags().Changed("after") {
+		return board.PlaceAfter, id, fmt.Sprintf("after #%d in", id)
+	}
+	if cmd.Flags().Changed("bottom") {
+		return board.PlaceBottom, 0, "at the bottom of"
+	}
+	return board.PlaceTop, 0, "at the top of"
+}
+
+// runMoveRank reorders one task within its column, first moving it to the
+// STATUS argument (or along --next/--prev) when one is given.
+func runMoveRank(cfg *config.Config, ids []int, cmd *cobra.Command, args []string) error {
+	if len(ids) != 1 {
+		return clierr.New(clierr.InvalidInput, "--top, --bottom, --before and --after take a single task ID")
+	}
+	id := ids[0]
+	where, anchorID, desc := placementFromFlags(cmd)
+	if anchorID == id && (where == board.PlaceBefore || where == board.PlaceAfter) {
+		return clierr.Newf(clierr.InvalidInput, "cannot place task #%d relative to itself", id)
+	}
+
+	var (
+		t         *task.Task
+		oldStatus string
+	)
+	err := withBoardLock(cfg, func() error {
+		var err error
+		t, oldStatus, err = moveOrReadTask(cfg, id, anchorID, cmd, args)
+		if err != nil {
+			return err
+		}
+		return rankTask(cfg, t, where, anchorID)
+	})
+	if err != nil {
+		return err
+	}
+	logActivity(cfg, "rank", t.ID, desc+" "+t.Status)
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, moveResult{Task: t, Changed: true})
+	}
+	if oldStatus != "" {
+		output.Messagef(os.Stdout, "Moved task #%d: %s -> %s", t.ID, oldStatus, t.Status)
+	}
+	output.Messagef(os.Stdout, "Placed task #%d %s %s", t.ID, desc, t.Status)
+	return nil
+}
+
+// moveOrReadTask runs moveTask when a target status was given; otherwise it
+// reads the task and checks its claim. A non-zero anchorID must be in the
+// target status, which is checked before anything is written. Must be called
+// under the board lock.
+func moveOrReadTask(cfg *config.Config, id, anchorID int, cmd *cobra.Command, args []string) (*task.Task, string, error) {
+	t, err := readTaskByID(cfg, id)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next, _ := cmd.Flags().GetBool("next")
+	prev, _ := cmd.Flags().GetBool("prev")
+	if len(args) == 2 || next || prev { //nolint:mnd // ID and STATUS
+		if anchorID != 0 {
+			target, err := resolveTargetStatus(cmd, args, t, cfg)
+			if err != nil {
+				return nil, "", err
+			}
+			if err := checkAnchorStatus(cfg, anchorID, target); err != nil {
+				return nil, "", err
+			}
+		}
+		return moveTask(cfg, id, cmd, args)
+	}
+
+	claimant, _ := cmd.Flags().GetString("claim")
+	if err := validateMoveClaim(cfg, t, claimant); err != nil {
+		return nil, "", err
+	}
+	setActor(claimant)
+	return t, "", nil
+}
+
+// rankTask assigns t a rank within its column and writes every task whose
+// rank changed. Must be called under the board lock.
+func rankTask(cfg *config.Config, t *task.Task, where board.Placement, anchorID int) error {
+	tasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	column := board.Filter(tasks, board.FilterOptions{Statuses: []string{t.Status}})
+
+	var anchor *task.Task
+	if where == board.PlaceBefore || where == board.PlaceAfter {
+		for _, c := range column {
+			if c.ID == anchorID {
+				anchor = c
+			}
+		}
+		if anchor == nil {
+			return anchorStatusError(anchorID, t.Status)
+		}
+	}
+
+	t.Updated = time.Now()
+	for _, c := range board.Rerank(column, t, where, anchor) {
+		if err := task.Write(c.File, c); err != nil {
+			return fmt.Errorf("writing task #%d: %w", c.ID, err)
+		}
+	}
+	return nil
+}
+
+// checkAnchorStatus returns an error unless task anchorID is in status.
+func checkAnchorStatus(cfg *config.Config, anchorID int, status string) error {
+	anchor, err := readTaskByID(cfg, anchorID)
+	if err != nil {
+		return err
+	}
+	if anchor.Status != status {
+		return anchorStatusError(anchorID, status)
+	}
+	return nil
+}
+
+// anchorStatusError reports a --before/--after task outside the column.
+func anchorStatusError(anchorID int, status string) error {
+	return clierr.Newf(clierr.InvalidInput, "task #%d is not in %s", anchorID, status).
+		WithDetails(map[string]any{"id": anchorID, "status": status})
+}
@@ -4,11 +4,15 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
@@ -22,36 +26,98 @@ var version = "dev"
 
 // Global flags.
 var (
-	flagJSON    bool
-	flagTable   bool
-	flagCompact bool
-	flagDir     string
-	flagNoColor bool
+	flagJSON        bool
+	flagJSONCompact bool
+	flagJSONL       bool
+	flagTable       bool
+	flagCompact     bool
+	flagFormat      string
+	flagDir         string
+	flagNoColor     bool
+	flagColor       string
+	flagQuiet       bool
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "agentwatch",
 	Short: "Terminal UI for watching AI agents work",
 	Long: `agentwatch displays a live Kanban board showing what your AI agents are doing.
-Just run agentwatch to open the TUI. AI tools create and move cards via hooks.`,
+Just run agentwatch to open the TUI. AI tools create and move cards via hooks.
+
+Environment (flags take precedence):
+  AGENTWATCH_DIR             project directory, like --dir
+  AGENTWATCH_OUTPUT          default output format, like --format (KANBAN_OUTPUT also works)
+  AGENTWATCH_NO_COLOR        disable color output when set, like --color never (NO_COLOR also works)
+  AGENTWATCH_WATCH_MODE      "poll" to poll for changes instead of using file events
+  AGENTWATCH_WATCH_INTERVAL  poll interval as a duration (default 1s)`,
 	Version:       version,
 	SilenceErrors: true,
 	SilenceUsage:  true,
 	RunE:          runTUI,
-	PersistentPreRun: func(_ *cobra.Command, _ []string) {
-		if flagNoColor || os.Getenv("NO_COLOR") != "" {
-			output.DisableColor()
+	PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		if err := applyColorMode(cmd); err != nil {
+			return err
+		}
+		output.SetQuiet(flagQuiet)
+		if _, ok := output.ParseFormat(flagFormat); flagFormat != "" && !ok {
+			return clierr.Newf(clierr.InvalidInput, "invalid --format %q; valid: %s",
+				flagFormat, strings.Join(output.FormatNames(), ", "))
 		}
+		if !flagJSON {
+			output.SetCompactJSON(output.DetectCompactJSON(flagJSONCompact, flagFormat))
+		}
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.PersistentFlags().BoolVar(&flagJSON, "json", false, "output as JSON")
+	rootCmd.PersistentFlags().BoolVar(&flagJSONCompact, "json-compact", false, "output as single-line JSON")
+	rootCmd.PersistentFlags().BoolVar(&flagJSONL, "jsonl", false, "output task lists as JSON lines (one object per line)")
 	rootCmd.PersistentFlags().BoolVar(&flagTable, "table", false, "output as table")
 	rootCmd.PersistentFlags().BoolVar(&flagCompact, "compact", false, "compact one-line-per-record output")
 	rootCmd.PersistentFlags().BoolVar(&flagCompact, "oneline", false, "alias for --compact")
+	rootCmd.PersistentFlags().StringVar(&flagFormat, "format", "", "output format ("+strings.Join(output.FormatNames(), ", ")+")")
 	rootCmd.PersistentFlags().StringVar(&flagDir, "dir", "", "path to kanban directory")
-	rootCmd.PersistentFlags().BoolVar(&flagNoColor, "no-color", false, "disable color output")
+	rootCmd.PersistentFlags().StringVar(&flagColor, "color", colorAuto, "when to use color: always, auto (if stdout is a terminal) or never")
+	rootCmd.PersistentFlags().BoolVar(&flagNoColor, "no-color", false, "disable color output (alias for --color never)")
+	rootCmd.PersistentFlags().BoolVarP(&flagQuiet, "quiet", "q", false, "suppress success messages (errors are still printed)")
+}
+
+// Values of --color.
+const (
+	colorAlways = "always"
+	colorAuto   = "auto"
+	colorNever  = "never"
+)
+
+// applyColorMode resolves --color, --no-color and the NO_COLOR variables.
+// An explicit --color wins over the environment.
+func applyColorMode(cmd *cobra.Command) error {
+	mode := flagColor
+	switch mode {
+	case colorAlways, colorAuto, colorNever:
+	default:
+		return clierr.Newf(clierr.InvalidInput, "invalid --color %q; valid: always, auto, never", mode)
+	}
+	colorSet := cmd.Flags().Changed("color")
+	if flagNoColor {
+		if colorSet && mode != colorNever {
+			return clierr.Newf(clierr.InvalidInput, "cannot use --no-color and --color %s together", mode)
+		}
+		mode = colorNever
+	}
+	if !colorSet && (os.Getenv("AGENTWATCH_NO_COLOR") != "" || os.Getenv("NO_COLOR") != "") {
+		mode = colorNever
+	}
+
+	switch {
+	case mode == colorAlways:
+		output.ForceColor()
+	case mode == colorNever, !term.IsTerminal(int(os.Stdout.Fd())):
+		output.DisableColor()
+	}
+	return nil
 }
 
 // Execute runs the root command.
@@ -68,10 +134,7 @@ func Execute() {
 	}
 
 	// Determine if JSON mode is active.
-	jsonMode := flagJSON
-	if !jsonMode {
-		jsonMode = os.Getenv("KANBAN_OUTPUT") == "json"
-	}
+	jsonMode := outputFormat() == output.FormatJSON
 
 	if jsonMode {
 		var cliErr *clierr.Error
@@ -103,12 +166,15 @@ func defaultHomeDir() (string, error) {
 }
 
 // resolveDir returns the absolute path to the agentwatch data directory.
-// When --dir is set, resolves to <dir>/.agents/agentwatch.
-// Otherwise falls back to ~/.config/agentwatch.
+// When --dir (or else $AGENTWATCH_DIR) is set, resolves to
+// <dir>/.agents/agentwatch. Otherwise falls back to ~/.config/agentwatch.
 func resolveDir() (string, error) {
 	if flagDir != "" {
 		return filepath.Join(flagDir, ".agents", "agentwatch"), nil
 	}
+	if dir := os.Getenv("AGENTWATCH_DIR"); dir != "" {
+		return filepath.Join(dir, ".agents", "agentwatch"), nil
+	}
 
 	// Fall back to ~/.config/agentwatch.
 	return defaultHomeDir()
@@ -122,20 +188,36 @@ func loadConfig() (*config.Config, error) {
 	}
 
 	cfg, err := config.Load(dir)
-	if err == nil {
-		return cfg, nil
+	if errors.Is(err, config.ErrNotFound) {
+		cfg, err = config.InitAgent(dir)
 	}
-
-	if !errors.Is(err, config.ErrNotFound) {
+	if err != nil {
 		return nil, err
 	}
+	applyPriorityColors(cfg)
+	output.SetClaimTimeout(cfg.ClaimTimeoutDuration())
+	board.SetLogMaxFiles(cfg.Log.MaxFiles)
+	return cfg, nil
+}
 
-	return config.InitAgent(dir)
+// withBoardLock runs fn while holding the board lock, serializing it with
+// other mutating commands and the TUI.
+func withBoardLock(cfg *config.Config, fn func() error) error {
+	return board.WithLock(cfg.Dir(), fn)
+}
+
+// applyPriorityColors hands the board's priority colors to the output package.
+func applyPriorityColors(cfg *config.Config) {
+	colors := make(map[string]string, len(cfg.Priorities))
+	for _, p := range cfg.Priorities {
+		colors[p.Name] = cfg.PriorityColor(p.Name)
+	}
+	output.SetPriorityColors(colors)
 }
 
 // outputFormat returns the detected output format from flags/env.
 func outputFormat() output.Format {
-	return output.Detect(flagJSON, flagTable, flagCompact)
+	return output.Detect(flagJSON || flagJSONCompact, flagJSONL, flagTable, flagCompact, flagFormat)
 }
 
 // printWarnings writes task read warnings to stderr.
@@ -145,6 +227,26 @@ func printWarnings(warnings []task.ReadWarning) {
 	}
 }
 
+// checkBodySize rejects a task whose body exceeds limits.max_body_bytes.
+func checkBodySize(cfg *config.Config, t *task.Task) error {
+	limit := cfg.MaxBodyBytes()
+	if len(t.Body) <= limit {
+		return nil
+	}
+	return clierr.Newf(clierr.InvalidInput, "task body is %d bytes, exceeding the %d byte limit", len(t.Body), limit).
+		WithDetails(map[string]any{"size": len(t.Body), "limit": limit})
+}
+
+// checkClassPolicy enforces class-of-service rules on a task: classes with
+// require_due need a due date.
+func checkClassPolicy(cfg *config.Config, t *task.Task) error {
+	if cl := cfg.ClassByName(t.Class); cl != nil && cl.RequireDue && t.Due == nil {
+		return clierr.Newf(clierr.InvalidInput, "class %q requires a due date (--due)", t.Class).
+			WithDetails(map[string]any{"class": t.Class})
+	}
+	return nil
+}
+
 // validateDepIDs checks that all dependency IDs exist and none are self-referencing.
 func validateDepIDs(tasksDir string, selfID int, ids []int) error {
 	return task.ValidateDependencyIDs(tasksDir, selfID, ids)
@@ -159,7 +261,49 @@ func checkWIPLimit(cfg *config.Config, statusCounts map[string]int, targetStatus
 // logActivity appends an entry to the activity log. Errors are silently
 // discarded because logging should never fail a command.
 func logActivity(cfg *config.Config, action string, taskID int, detail string) {
-	board.LogMutation(cfg.Dir(), action, taskID, detail)
+	logEntry(cfg, board.LogEntry{Action: action, TaskID: taskID, Detail: detail})
+}
+
+// logActivityWithReason is logActivity with an optional rationale.
+func logActivityWithReason(cfg *config.Config, action string, taskID int, detail, reason string) {
+	logEntry(cfg, board.LogEntry{Action: action, TaskID: taskID, Detail: detail, Reason: reason})
+}
+
+// logEntry appends entry to the activity log with the invocation's actor.
+// Commands that load the config without loadConfig still get its log
+// retention.
+func logEntry(cfg *config.Config, entry board.LogEntry) {
+	board.SetLogMaxFiles(cfg.Log.MaxFiles)
+	entry.Actor = logActor
+	board.LogMutationEntry(cfg.Dir(), entry)
+}
+
+// logActor is the actor recorded on activity log entries. Commands that know
+// who is acting (a --claim name or comment author) set it with setActor;
+// otherwise board.DefaultActor fills it in.
+var logActor string
+
+// setActor records name as the actor for this invocation's log entries. An
+// empty name leaves the default in place.
+func setActor(name string) {
+	if name != "" {
+		logActor = name
+	}
+}
+
+// recordTransition appends a status change by this invocation's actor to the
+// task's embedded history.
+func recordTransition(cfg *config.Config, t *task.Task, from, to string) {
+	task.RecordTransition(t, from, to, currentActor(), time.Now(), cfg.MaxHistory())
+}
+
+// currentActor returns this invocation's actor, falling back to
+// board.DefaultActor.
+func currentActor() string {
+	if logActor != "" {
+		return logActor
+	}
+	return board.DefaultActor()
 }
 
 // checkClaim verifies that a mutating operation is allowed on a claimed task.
@@ -167,7 +311,8 @@ func checkClaim(t *task.Task, claimant string, timeout time.Duration) error {
 	return task.CheckClaim(t, claimant, timeout)
 }
 
-// validateDeps validates parent and dependency references for a task.
+// validateDeps validates parent and dependency references for a task,
+// rejecting references that would form a cycle.
 func validateDeps(cfg *config.Config, t *task.Task) error {
 	if t.Parent != nil {
 		if err := validateDepIDs(cfg.TasksPath(), t.ID, []int{*t.Parent}); err != nil {
@@ -179,14 +324,133 @@ func validateDeps(cfg *config.Config, t *task.Task) error {
 			return err
 		}
 	}
+	if t.Parent != nil || len(t.DependsOn) > 0 {
+		return task.ValidateNoCycles(cfg.TasksPath(), t)
+	}
 	return nil
 }
 
+// readBodyFile reads body text from path ("-" for stdin) verbatim, with CRLF
+// line endings normalized to LF. The size is not capped here: checkBodySize
+// applies limits.max_body_bytes once the board config is loaded.
+func readBodyFile(path string) (string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path) //nolint:gosec // user-provided body file
+		if err != nil {
+			return "", clierr.Newf(clierr.InvalidInput, "opening body file: %v", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", clierr.Newf(clierr.InvalidInput, "reading body file: %v", err)
+	}
+	return strings.ReplaceAll(string(data), "\r\n", "\n"), nil
+}
+
+// resolveBodyFileFlags reads each set file flag and stores its content in the
+// matching text flag (e.g. --body-file into --body), so the file is read once
+// even in batch mode and the existing body handling applies unchanged.
+func resolveBodyFileFlags(cmd *cobra.Command, pairs map[string]string) error {
+	for fileFlag, textFlag := range pairs {
+		if !cmd.Flags().Changed(fileFlag) {
+			continue
+		}
+		path, _ := cmd.Flags().GetString(fileFlag)
+		content, err := readBodyFile(path)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Flags().Set(textFlag, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveStdinBody reads the whole of stdin into --body when --stdin-body is
+// set. Combining it with any of the given body flags is a StatusConflict.
+func resolveStdinBody(cmd *cobra.Command, conflicting ...string) error {
+	if v, _ := cmd.Flags().GetBool("stdin-body"); !v {
+		return nil
+	}
+	for _, name := range conflicting {
+		if cmd.Flags().Changed(name) {
+			return clierr.Newf(clierr.StatusConflict, "cannot use --stdin-body and --%s together", name)
+		}
+	}
+	content, err := readBodyFile("-")
+	if err != nil {
+		return err
+	}
+	return cmd.Flags().Set("body", content)
+}
+
+// addArchivedFlags registers --include-archived and --archived on a read
+// command; read them back with archivedMode.
+func addArchivedFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("include-archived", false, "include archived tasks")
+	cmd.Flags().Bool("archived", false, "show only archived tasks")
+	cmd.MarkFlagsMutuallyExclusive("include-archived", "archived")
+}
+
+// archivedMode returns the archived visibility selected by addArchivedFlags.
+func archivedMode(cmd *cobra.Command) board.ArchivedMode {
+	if v, _ := cmd.Flags().GetBool("archived"); v {
+		return board.ArchivedOnly
+	}
+	if v, _ := cmd.Flags().GetBool("include-archived"); v {
+		return board.ArchivedInclude
+	}
+	return board.ArchivedDefault
+}
+
 // parseIDs splits a comma-separated ID string into deduplicated int IDs.
 func parseIDs(arg string) ([]int, error) {
 	return board.ParseIDs(arg)
 }
 
+// stdinIDsArg is the ID argument that makes batch commands read IDs from stdin.
+const stdinIDsArg = "-"
+
+// maxStdinIDsSize caps the size of the ID list read from stdin.
+const maxStdinIDsSize = 1 << 20
+
+// readStdinIDs reads task IDs separated by whitespace, commas or newlines, as
+// piped from e.g. list --json | jq.
+func readStdinIDs(r io.Reader) ([]int, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxStdinIDsSize+1))
+	if err != nil {
+		return nil, clierr.Newf(clierr.InvalidInput, "reading IDs from stdin: %v", err)
+	}
+	if len(data) > maxStdinIDsSize {
+		return nil, clierr.Newf(clierr.InvalidInput, "stdin exceeds %d bytes", maxStdinIDsSize)
+	}
+	fields := strings.FieldsFunc(string(data), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	if len(fields) == 0 {
+		return nil, clierr.New(clierr.InvalidTaskID, "no task IDs on stdin")
+	}
+	return parseIDs(strings.Join(fields, ","))
+}
+
+// batchResult reports the outcome of one batch operation on id, using the
+// CLIError message and code when err carries one.
+func batchResult(id int, err error) output.BatchResult {
+	if err == nil {
+		return output.BatchResult{ID: id, OK: true}
+	}
+	var cliErr *clierr.Error
+	if errors.As(err, &cliErr) {
+		return output.BatchResult{ID: id, Error: cliErr.Message, Code: cliErr.Code}
+	}
+	return output.BatchResult{ID: id, Error: err.Error()}
+}
+
 // runBatch executes fn for each ID and collects results. Returns a SilentError
 // with exit code 1 if any operation failed (after outputting results).
 func runBatch(ids []int, fn func(int) error) error {
@@ -194,18 +458,9 @@ func runBatch(ids []int, fn func(int) error) error {
 	anyFailed := false
 
 	for _, id := range ids {
-		err := fn(id)
-		if err != nil {
-			anyFailed = true
-			var cliErr *clierr.Error
-			if errors.As(err, &cliErr) {
-				results = append(results, output.BatchResult{ID: id, OK: false, Error: cliErr.Message, Code: cliErr.Code})
-			} else {
-				results = append(results, output.BatchResult{ID: id, OK: false, Error: err.Error()})
-			}
-		} else {
-			results = append(results, output.BatchResult{ID: id, OK: true})
-		}
+		r := batchResult(id, fn(id))
+		anyFailed = anyFailed || !r.OK
+		results = append(results, r)
 	}
 
 	if outputFormat() == output.FormatJSON {
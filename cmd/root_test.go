@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveDirPrecedence(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	tests := []struct {
+		name string
+		flag string
+		env  string
+		want string
+	}{
+		{"flag wins over env", "/flag", "/env", "/flag/.agents/agentwatch"},
+		{"env without flag", "", "/env", "/env/.agents/agentwatch"},
+		{"home without flag or env", "", "", filepath.Join(home, ".config/agentwatch")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AGENTWATCH_DIR", tt.env)
+			saved := flagDir
+			flagDir = tt.flag
+			t.Cleanup(func() { flagDir = saved })
+
+			got, err := resolveDir()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != filepath.FromSlash(tt.want) {
+				t.Errorf("resolveDir() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
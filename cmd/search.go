@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var searchCmd = &cobra.Command{
+	Use:     "search QUERY",
+	Aliases: []string{"find"},
+	Short:   "Search tasks ranked by relevance",
+	Long: `Finds tasks whose title, tags, or body contain QUERY (case-insensitive).
+Results are ranked: title matches first, then tag matches, then body matches,
+with ties broken by most recently updated. Archived tasks are excluded unless
+--include-archived (or --archived, for archived tasks only) is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSearch,
+}
+
+func init() {
+	searchCmd.Flags().Int("limit", 0, "maximum number of results")
+	addArchivedFlags(searchCmd)
+	rootCmd.AddCommand(searchCmd)
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	query := strings.TrimSpace(args[0])
+	if query == "" {
+		return clierr.New(clierr.InvalidInput, "search query is required")
+	}
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	var filter board.FilterOptions
+	board.ApplyArchived(&filter, archivedMode(cmd))
+	tasks = board.Filter(tasks, filter)
+
+	results := board.SearchRanked(tasks, query)
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	switch outputFormat() {
+	case output.FormatJSON:
+		if results == nil {
+			results = []board.SearchResult{}
+		}
+		return output.JSON(os.Stdout, results)
+	case output.FormatCompact:
+		output.TaskCompact(os.Stdout, searchTasks(results))
+	case output.FormatCSV:
+		return output.TaskCSV(os.Stdout, searchTasks(results))
+	default:
+		output.SearchTable(os.Stdout, results, query)
+	}
+	return nil
+}
+
+// searchTasks unwraps the tasks from search results, preserving rank order.
+func searchTasks(results []board.SearchResult) []*task.Task {
+	tasks := make([]*task.Task, len(results))
+	for i, r := range results {
+		tasks[i] = r.Task
+	}
+	return tasks
+}
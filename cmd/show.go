@@ -1,31 +1,45 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
-	"strconv"
 
 	"github.com/spf13/cobra"
 
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
 
 var showCmd = &cobra.Command{
-	Use:   "show ID",
+	Use:   "show ID[,ID,...]",
 	Short: "Show task details",
-	Long:  `Displays full details of a single task including its markdown body.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runShow,
+	Long: `Displays full details of a task including its markdown body. Multiple IDs
+can be provided as a comma-separated list; missing IDs are reported per task
+without failing the others. Use --body-only to print just the markdown body,
+for piping into other tools.
+
+Use --history to show the task's timeline instead: creation, status moves,
+claims, blocks, and edits recorded in the activity log. Use --comments-only
+to print just the comments added with "agentwatch comment".`,
+	Args: cobra.ExactArgs(1),
+	RunE: runShow,
 }
 
 func init() {
+	showCmd.Flags().Bool("history", false, "show the task's chronological history")
+	showCmd.Flags().Bool("comments-only", false, "show only the task's comments")
+	showCmd.Flags().Bool("body-only", false, "print only the raw markdown body")
+	showCmd.MarkFlagsMutuallyExclusive("history", "comments-only", "body-only")
 	rootCmd.AddCommand(showCmd)
 }
 
-func runShow(_ *cobra.Command, args []string) error {
-	id, err := strconv.Atoi(args[0])
+func runShow(cmd *cobra.Command, args []string) error {
+	ids, err := parseIDs(args[0])
 	if err != nil {
-		return task.ValidateTaskID(args[0])
+		return err
 	}
 
 	cfg, err := loadConfig()
@@ -33,16 +47,28 @@ func runShow(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	path, err := task.FindByID(cfg.TasksPath(), id)
-	if err != nil {
-		return err
+	if len(ids) > 1 {
+		return showMultiple(cmd, cfg, ids)
 	}
 
-	t, err := task.Read(path)
+	t, err := readTaskByID(cfg, ids[0])
 	if err != nil {
 		return err
 	}
 
+	if history, _ := cmd.Flags().GetBool("history"); history {
+		return showHistory(cfg, t)
+	}
+
+	if commentsOnly, _ := cmd.Flags().GetBool("comments-only"); commentsOnly {
+		return showComments(t)
+	}
+
+	if bodyOnly, _ := cmd.Flags().GetBool("body-only"); bodyOnly {
+		showBody(t)
+		return nil
+	}
+
 	format := outputFormat()
 	if format == output.FormatJSON {
 		return output.JSON(os.Stdout, t)
@@ -51,7 +77,132 @@ func runShow(_ *cobra.Command, args []string) error {
 		output.TaskDetailCompact(os.Stdout, t)
 		return nil
 	}
+	if format == output.FormatCSV {
+		return output.TaskCSV(os.Stdout, []*task.Task{t})
+	}
 
 	output.TaskDetail(os.Stdout, t)
 	return nil
 }
+
+// readTaskByID finds and reads a task.
+func readTaskByID(cfg *config.Config, id int) (*task.Task, error) {
+	path, err := task.FindByID(cfg.TasksPath(), id)
+	if err != nil {
+		return nil, err
+	}
+	return task.Read(path)
+}
+
+// showBatchEntry is one element of multi-ID show JSON output.
+type showBatchEntry struct {
+	output.BatchResult
+	Task *task.Task `json:"task,omitempty"`
+}
+
+// showMultiple shows several tasks, reporting missing or unreadable ones
+// per ID. Exits 1 (after output) if any failed.
+func showMultiple(cmd *cobra.Command, cfg *config.Config, ids []int) error {
+	history, _ := cmd.Flags().GetBool("history")
+	commentsOnly, _ := cmd.Flags().GetBool("comments-only")
+	if history || commentsOnly {
+		return clierr.New(clierr.InvalidInput, "--history and --comments-only work on a single task")
+	}
+	bodyOnly, _ := cmd.Flags().GetBool("body-only")
+
+	entries := make([]showBatchEntry, 0, len(ids))
+	tasks := make([]*task.Task, 0, len(ids))
+	anyFailed := false
+	for _, id := range ids {
+		t, err := readTaskByID(cfg, id)
+		if err != nil {
+			anyFailed = true
+			e := showBatchEntry{BatchResult: batchResult(id, err)}
+			entries = append(entries, e)
+			if outputFormat() != output.FormatJSON {
+				fmt.Fprintf(os.Stderr, "Error: task #%d: %s\n", id, e.Error)
+			}
+			continue
+		}
+		entries = append(entries, showBatchEntry{BatchResult: batchResult(id, nil), Task: t})
+		tasks = append(tasks, t)
+	}
+
+	if err := outputShowMultiple(entries, tasks, bodyOnly); err != nil {
+		return err
+	}
+	if anyFailed {
+		return &clierr.SilentError{Code: 1}
+	}
+	return nil
+}
+
+func outputShowMultiple(entries []showBatchEntry, tasks []*task.Task, bodyOnly bool) error {
+	format := outputFormat()
+	switch {
+	case bodyOnly:
+		for i, t := range tasks {
+			if i > 0 {
+				fmt.Fprintln(os.Stdout)
+			}
+			showBody(t)
+		}
+	case format == output.FormatJSON:
+		return output.JSON(os.Stdout, entries)
+	case format == output.FormatCSV:
+		return output.TaskCSV(os.Stdout, tasks)
+	default:
+		for i, t := range tasks {
+			if i > 0 {
+				fmt.Fprintln(os.Stdout)
+			}
+			if format == output.FormatCompact {
+				output.TaskDetailCompact(os.Stdout, t)
+			} else {
+				output.TaskDetail(os.Stdout, t)
+			}
+		}
+	}
+	return nil
+}
+
+// showBody prints the task body verbatim, without styling.
+func showBody(t *task.Task) {
+	if t.Body != "" {
+		fmt.Fprintln(os.Stdout, t.Body)
+	}
+}
+
+// showHistory renders the merged timeline of a task's timestamps and log entries.
+func showHistory(cfg *config.Config, t *task.Task) error {
+	entries, err := board.ReadLog(cfg.Dir())
+	if err != nil {
+		return err
+	}
+
+	h := board.TaskHistory(t, entries)
+	if h.Incomplete {
+		fmt.Fprintf(os.Stderr, "Warning: activity log starts after task #%d was created; history may be incomplete\n", t.ID)
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, h.Entries)
+	}
+
+	output.HistoryTable(os.Stdout, h)
+	return nil
+}
+
+// showComments prints only the comment blocks from the task body.
+func showComments(t *task.Task) error {
+	comments := task.ParseComments(t.Body)
+	if outputFormat() == output.FormatJSON {
+		if comments == nil {
+			comments = []task.Comment{}
+		}
+		return output.JSON(os.Stdout, comments)
+	}
+
+	output.CommentList(os.Stdout, comments)
+	return nil
+}
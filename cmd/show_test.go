@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestShowBatchEntryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		entry showBatchEntry
+		want  string
+	}{
+		{
+			"found",
+			showBatchEntry{BatchResult: batchResult(1, nil), Task: &task.Task{ID: 1, Title: "a"}},
+			`{"id":1,"ok":true,"task":{"id":1,"title":"a","status":"","priority":"","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}}`,
+		},
+		{
+			"missing",
+			showBatchEntry{BatchResult: batchResult(9, clierr.New(clierr.TaskNotFound, "task #9 not found"))},
+			`{"id":9,"ok":false,"error":"task #9 not found","code":"TASK_NOT_FOUND"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.entry)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("JSON = %s\nwant  %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show flow metrics",
+	Long: `Computes flow metrics for retrospectives: weekly throughput, lead time
+(created to completed), cycle time (started to completed), and the current
+work in progress per status.
+
+--since accepts a date (YYYY-MM-DD) or a duration such as 30d or 2w.
+Archived tasks count toward historical throughput.`,
+	Args: cobra.NoArgs,
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().String("since", "", "only count tasks completed since DATE or DURATION (e.g. 30d)")
+	statsCmd.Flags().StringSlice("tag", nil, "filter by tag (repeatable; tasks must have all)")
+	statsCmd.Flags().String("assignee", "", "filter by assignee")
+	_ = statsCmd.RegisterFlagCompletionFunc("assignee", completeAssignees)
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var since *time.Time
+	if v, _ := cmd.Flags().GetString("since"); v != "" {
+		ts, parseErr := date.ParseSince(v, now)
+		if parseErr != nil {
+			return clierr.New(clierr.InvalidInput, parseErr.Error()).
+				WithDetails(map[string]any{"flag": "since", "input": v})
+		}
+		since = &ts
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	assignee, _ := cmd.Flags().GetString("assignee")
+	tasks = board.Filter(tasks, board.FilterOptions{Tags: tags, Assignee: assignee})
+
+	stats := board.Stats(cfg, tasks, since, now)
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, stats)
+	}
+	output.StatsTable(os.Stdout, stats)
+	return nil
+}
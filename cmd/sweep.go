@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var sweepCmd = &cobra.Command{
+	Use:   "sweep",
+	Short: "Archive tasks completed longer ago than auto_archive_after",
+	Long: `Moves tasks in a terminal status whose completed timestamp is older than
+the auto_archive_after config value (e.g. 14d) to the archived status. Each
+archived task is logged as "auto-archive".
+
+Set the policy with: agentwatch config set auto_archive_after 14d
+Use --dry-run to list the tasks that would be archived.`,
+	Args: cobra.NoArgs,
+	RunE: runSweep,
+}
+
+func init() {
+	sweepCmd.Flags().Bool("dry-run", false, "list tasks that would be archived without writing")
+	rootCmd.AddCommand(sweepCmd)
+}
+
+// sweepResult is the JSON output of sweep.
+type sweepResult struct {
+	Archived []int `json:"archived"`
+	DryRun   bool  `json:"dry_run,omitempty"`
+}
+
+func runSweep(cmd *cobra.Command, _ []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	after := cfg.AutoArchiveDuration()
+	if after == 0 {
+		return clierr.New(clierr.InvalidInput,
+			"auto_archive_after is not set; set it with: agentwatch config set auto_archive_after DURATION")
+	}
+
+	var due []*task.Task
+	var from []string
+	ids := []int{}
+	err = withBoardLock(cfg, func() error {
+		tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+		if err != nil {
+			return err
+		}
+		printWarnings(warnings)
+
+		due = sweepCandidates(cfg, tasks, time.Now().Add(-after))
+		board.SortByID(due)
+		for _, t := range due {
+			from = append(from, t.Status)
+			if !dryRun {
+				if err := autoArchive(cfg, t); err != nil {
+					return err
+				}
+			}
+			ids = append(ids, t.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, sweepResult{Archived: ids, DryRun: dryRun})
+	}
+	verb := "Archived"
+	if dryRun {
+		verb = "Dry run: would archive"
+	}
+	for i, t := range due {
+		output.Messagef(os.Stdout, "  #%d %s (%s)", t.ID, t.Title, from[i])
+	}
+	output.Messagef(os.Stdout, "%s %d task(s) completed more than %s ago", verb, len(ids), cfg.AutoArchiveAfter)
+	return nil
+}
+
+// sweepCandidates returns the non-archived terminal tasks completed before
+// cutoff.
+func sweepCandidates(cfg *config.Config, tasks []*task.Task, cutoff time.Time) []*task.Task {
+	var due []*task.Task
+	for _, t := range tasks {
+		if t.Status == config.ArchivedStatus || !cfg.IsTerminalStatus(t.Status) {
+			continue
+		}
+		if t.Completed != nil && t.Completed.Before(cutoff) {
+			due = append(due, t)
+		}
+	}
+	return due
+}
+
+// autoArchive moves t to the archived status and logs the auto-archive action.
+func autoArchive(cfg *config.Config, t *task.Task) error {
+	oldStatus := t.Status
+	t.Status = config.ArchivedStatus
+	task.UpdateTimestamps(t, oldStatus, t.Status, cfg)
+	recordTransition(cfg, t, oldStatus, t.Status)
+	t.Updated = time.Now()
+
+	if err := task.Write(t.File, t); err != nil {
+		return fmt.Errorf("writing task #%d: %w", t.ID, err)
+	}
+	logActivity(cfg, "auto-archive", t.ID, fmt.Sprintf("%s -> %s", oldStatus, t.Status))
+	return nil
+}
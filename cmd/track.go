@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// trackCmd is the deprecated name of worklog start/stop, kept so existing
+// scripts keep working. It records the same entries as worklog.
+var trackCmd = &cobra.Command{
+	Use:        "track",
+	Short:      "Track working time on a task (deprecated: use worklog)",
+	Hidden:     true,
+	Deprecated: `use "worklog start" and "worklog stop" instead`,
+}
+
+var trackStartCmd = &cobra.Command{
+	Use:        "start ID",
+	Short:      "Open a worklog entry on a task",
+	Args:       cobra.ExactArgs(1),
+	Deprecated: `use "worklog start" instead`,
+	RunE:       runWorklogStart,
+}
+
+var trackStopCmd = &cobra.Command{
+	Use:        "stop ID",
+	Short:      "Close the open worklog entry on a task",
+	Args:       cobra.ExactArgs(1),
+	Deprecated: `use "worklog stop" instead`,
+	RunE:       runWorklogStop,
+}
+
+func init() {
+	trackCmd.PersistentFlags().String("claim", "", "claimant name for claimed tasks")
+	trackStartCmd.Flags().String("note", "", "what the time is spent on")
+	trackCmd.AddCommand(trackStartCmd)
+	trackCmd.AddCommand(trackStopCmd)
+	rootCmd.AddCommand(trackCmd)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var treeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Show tasks as a parent/child tree",
+	Long: `Prints non-archived tasks nested under their parents, with a progress
+summary of each parent's children (e.g. "[2/5 done]"). Tasks whose parent is
+archived or missing are shown at the top level, marked with "!".
+
+Use --root to show a single task's subtree, and --status to keep only tasks
+in the given statuses (and the parents needed to reach them).`,
+	Args: cobra.NoArgs,
+	RunE: runTree,
+}
+
+func init() {
+	treeCmd.Flags().Int("root", 0, "show only the subtree under this task ID")
+	treeCmd.Flags().StringSlice("status", nil, "only show tasks in these statuses (comma-separated)")
+	_ = treeCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	rootCmd.AddCommand(treeCmd)
+}
+
+func runTree(cmd *cobra.Command, _ []string) error {
+	rootID, _ := cmd.Flags().GetInt("root")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	for i, s := range statuses {
+		if statuses[i], err = task.ResolveStatus(cfg, s); err != nil {
+			return err
+		}
+	}
+	if rootID != 0 {
+		if _, err := task.FindByID(cfg.TasksPath(), rootID); err != nil {
+			return err
+		}
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	forest := board.Tree(cfg, tasks, board.TreeOptions{RootID: rootID, Statuses: statuses})
+
+	if outputFormat() == output.FormatJSON {
+		if forest == nil {
+			forest = []*board.TreeNode{}
+		}
+		return output.JSON(os.Stdout, forest)
+	}
+	output.TaskTree(os.Stdout, forest)
+	return nil
+}
@@ -22,20 +22,16 @@ func runTUI(_ *cobra.Command, _ []string) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	go startTUIWatcher(ctx, model, p)
-
-	_, err = p.Run()
-	return err
-}
-
-func startTUIWatcher(ctx context.Context, model *tui.Board, p *tea.Program) {
-	paths := model.WatchPaths()
-	w, err := watcher.New(paths, func() {
+	// Start the watcher before the alt screen so a polling warning stays
+	// readable.
+	w, err := watcher.New(model.WatchPaths(), func() {
 		p.Send(tui.ReloadMsg{})
 	})
-	if err != nil {
-		return // non-fatal: TUI works without live refresh
+	if err == nil { // non-fatal: TUI works without live refresh
+		defer w.Close()
+		go w.Run(ctx, nil)
 	}
-	defer w.Close()
-	w.Run(ctx, nil)
+
+	_, err = p.Run()
+	return err
 }
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// defaultWhereMax caps how many tasks a --where selection may touch.
+const defaultWhereMax = 50
+
+// addWhereFlags registers the flags for selecting tasks by filter.
+func addWhereFlags(cmd *cobra.Command) {
+	cmd.Flags().String("where", "", "select tasks by filter instead of IDs (e.g. status=todo,tag=frontend)")
+	cmd.Flags().Int("max", defaultWhereMax, "with --where, refuse to touch more than this many tasks")
+	cmd.Flags().BoolP("yes", "y", false, "with --where, skip the confirmation prompt")
+}
+
+// resolveWhere returns the IDs of tasks matching --where, after enforcing
+// --max and confirming with the user unless --yes is set. A nil slice with
+// a nil error means the user declined.
+func resolveWhere(cmd *cobra.Command, cfg *config.Config, verb string) ([]int, error) {
+	expr, _ := cmd.Flags().GetString("where")
+	limit, _ := cmd.Flags().GetInt("max")
+	yes, _ := cmd.Flags().GetBool("yes")
+
+	filter, err := board.ParseWhere(expr)
+	if err != nil {
+		return nil, err
+	}
+	filter.ClaimTimeout = cfg.ClaimTimeoutDuration()
+	for i, s := range filter.Statuses {
+		if filter.Statuses[i], err = task.ResolveStatus(cfg, s); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks, warnings, err := board.List(cfg, board.ListOptions{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	printWarnings(warnings)
+
+	if len(tasks) == 0 {
+		return []int{}, nil
+	}
+	if limit > 0 && len(tasks) > limit {
+		return nil, clierr.Newf(clierr.InvalidInput,
+			"--where matches %d tasks, more than --max %d; narrow the filter or raise --max", len(tasks), limit).
+			WithDetails(map[string]any{"matched": len(tasks), "max": limit})
+	}
+
+	if !yes {
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			return nil, clierr.New(clierr.ConfirmationReq,
+				"cannot prompt for confirmation (not a terminal); use --yes")
+		}
+		fmt.Fprintf(os.Stderr, "%s %d tasks matching %q? [y/N] ", verb, len(tasks), expr)
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		answer = strings.TrimSpace(strings.ToLower(answer))
+		if answer != "y" && answer != "yes" {
+			fmt.Fprintln(os.Stderr, "Canceled.")
+			return nil, nil
+		}
+	}
+
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+	return ids, nil
+}
+
+// runWhereBatch resolves --where and runs fn for each matching task through
+// runBatch.
+func runWhereBatch(cmd *cobra.Command, cfg *config.Config, verb string, fn func(int) error) error {
+	ids, err := resolveWhere(cmd, cfg, verb)
+	if err != nil || ids == nil {
+		return err
+	}
+	if len(ids) == 0 && outputFormat() != output.FormatJSON {
+		output.Messagef(os.Stdout, "No tasks match --where")
+		return nil
+	}
+	return runBatch(ids, fn)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var workloadCmd = &cobra.Command{
+	Use:   "workload",
+	Short: "Show committed work per assignee",
+	Long: `Groups open (non-terminal) tasks by assignee and sums their estimates,
+showing the total committed time and task count per person, highest total
+first. Tasks without an estimate are counted as unestimated, and tasks
+without an assignee are listed separately.`,
+	Args: cobra.NoArgs,
+	RunE: runWorkload,
+}
+
+func init() {
+	workloadCmd.Flags().StringSlice("tag", nil, "filter by tag (repeatable; tasks must have all)")
+	workloadCmd.Flags().String("class", "", "filter by class of service")
+	_ = workloadCmd.RegisterFlagCompletionFunc("class", completeClasses)
+	rootCmd.AddCommand(workloadCmd)
+}
+
+func runWorkload(cmd *cobra.Command, _ []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	tags, _ := cmd.Flags().GetStringSlice("tag")
+	class, _ := cmd.Flags().GetString("class")
+	tasks = board.Filter(tasks, board.FilterOptions{Tags: tags, Class: class})
+
+	wl := board.ComputeWorkload(cfg, tasks)
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, wl)
+	}
+	output.WorkloadTable(os.Stdout, wl)
+	return nil
+}
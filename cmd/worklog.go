@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/output"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var worklogCmd = &cobra.Command{
+	Use:   "worklog",
+	Short: "Log time worked on a task",
+	Long: `Records worklog entries (start, duration, agent, note) on a task. Use
+"worklog add ID 45m" to log work after the fact, or "worklog start ID" and
+"worklog stop ID" to log it as it happens. Entries are append-only; the total
+is shown by "show ID" and compared with the estimate by "stats".`,
+}
+
+var worklogAddCmd = &cobra.Command{
+	Use:   "add ID DURATION",
+	Short: "Log a finished piece of work on a task",
+	Args:  cobra.ExactArgs(2), //nolint:mnd // task ID and duration
+	RunE:  runWorklogAdd,
+}
+
+var worklogStartCmd = &cobra.Command{
+	Use:   "start ID",
+	Short: "Open a worklog entry on a task",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWorklogStart,
+}
+
+var worklogStopCmd = &cobra.Command{
+	Use:   "stop ID",
+	Short: "Close the open worklog entry on a task",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWorklogStop,
+}
+
+func init() {
+	worklogCmd.PersistentFlags().String("claim", "", "claimant name for claimed tasks")
+	worklogAddCmd.Flags().String("note", "", "what the time was spent on")
+	worklogStartCmd.Flags().String("note", "", "what the time is spent on")
+	worklogCmd.AddCommand(worklogAddCmd)
+	worklogCmd.AddCommand(worklogStartCmd)
+	worklogCmd.AddCommand(worklogStopCmd)
+	rootCmd.AddCommand(worklogCmd)
+}
+
+func runWorklogAdd(cmd *cobra.Command, args []string) error {
+	d, err := task.ParseWorklogDuration(args[1])
+	if err != nil {
+		return task.ValidateWorklogDuration(args[1], err)
+	}
+	note, _ := cmd.Flags().GetString("note")
+
+	t, err := updateTracking(cmd, args[0], func(t *task.Task, now time.Time) (string, error) {
+		task.AddWorklog(t, d, currentActor(), note, now)
+		return "worklog-add", nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, t)
+	}
+	output.Messagef(os.Stdout, "Logged %s on task #%d (total %s)",
+		output.FormatDuration(d), t.ID, output.FormatDuration(task.LoggedTime(t)))
+	return nil
+}
+
+func runWorklogStart(cmd *cobra.Command, args []string) error {
+	note, _ := cmd.Flags().GetString("note")
+
+	t, err := updateTracking(cmd, args[0], func(t *task.Task, now time.Time) (string, error) {
+		if err := task.StartWorklog(t, currentActor(), note, now); err != nil {
+			return "", err
+		}
+		return "worklog-start", nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, t)
+	}
+	output.Messagef(os.Stdout, "Started worklog entry on task #%d: %s", t.ID, t.Title)
+	return nil
+}
+
+func runWorklogStop(cmd *cobra.Command, args []string) error {
+	var elapsed time.Duration
+	t, err := updateTracking(cmd, args[0], func(t *task.Task, now time.Time) (string, error) {
+		d, err := task.StopWorklog(t, now)
+		if err != nil {
+			return "", err
+		}
+		elapsed = d
+		return "worklog-stop", nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if outputFormat() == output.FormatJSON {
+		return output.JSON(os.Stdout, t)
+	}
+	output.Messagef(os.Stdout, "Logged %s on task #%d (total %s)",
+		output.FormatDuration(elapsed), t.ID, output.FormatDuration(task.LoggedTime(t)))
+	return nil
+}
+
+// updateTracking loads a task, checks its claim, applies fn, then writes and
+// logs the change. fn returns the activity log action.
+func updateTracking(cmd *cobra.Command, arg string, fn func(*task.Task, time.Time) (string, error)) (*task.Task, error) {
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		return nil, task.ValidateTaskID(arg)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	claimant, _ := cmd.Flags().GetString("claim")
+	setActor(claimant)
+
+	var t *task.Task
+	err = withBoardLock(cfg, func() error {
+		path, err := task.FindByID(cfg.TasksPath(), id)
+		if err != nil {
+			return err
+		}
+		if t, err = task.Read(path); err != nil {
+			return err
+		}
+		if err = checkClaim(t, claimant, cfg.ClaimTimeoutDuration()); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		action, err := fn(t, now)
+		if err != nil {
+			return err
+		}
+		t.Updated = now
+		if err := task.Write(path, t); err != nil {
+			return fmt.Errorf("writing task: %w", err)
+		}
+		logActivity(cfg, action, t.ID, t.Title)
+		return nil
+	})
+	return t, err
+}
@@ -0,0 +1,45 @@
+// Package atomicfile writes files so that readers see either the old or the
+// new content, never a partial write.
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// syncFile flushes f to disk. Tests replace it to simulate a crash before
+// the rename.
+var syncFile = (*os.File).Sync
+
+// Write writes data to a temporary file in path's directory (so the rename
+// stays on one filesystem), syncs it to disk and renames it over path, then
+// syncs the directory so the rename itself survives a crash. The file gets
+// mode perm. On failure the original file is left untouched.
+func Write(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // no-op after a successful rename
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck,gosec // already failing
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close() //nolint:errcheck,gosec // already failing
+		return err
+	}
+	if err := syncFile(tmp); err != nil {
+		tmp.Close() //nolint:errcheck,gosec // already failing
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
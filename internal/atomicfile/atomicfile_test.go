@@ -0,0 +1,71 @@
+package atomicfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteReplacesContent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.md")
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Write(path, []byte("new"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "new" {
+		t.Errorf("content = %q, want %q", got, "new")
+	}
+	assertOnlyFile(t, path)
+}
+
+func TestWriteFailureKeepsOriginal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.md")
+	if err := os.WriteFile(path, []byte("original"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Fail after the data is written to the temporary file but before the
+	// rename, as a crash or full disk would.
+	errSync := errors.New("sync failed")
+	syncFile = func(*os.File) error { return errSync }
+	t.Cleanup(func() { syncFile = (*os.File).Sync })
+
+	if err := Write(path, []byte("partial"), 0o600); !errors.Is(err, errSync) {
+		t.Fatalf("Write = %v, want %v", err, errSync)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "original" {
+		t.Errorf("content = %q, want the original", got)
+	}
+	assertOnlyFile(t, path)
+}
+
+// assertOnlyFile fails if path's directory holds anything besides path, such
+// as a leftover temporary file.
+func assertOnlyFile(t *testing.T, path string) {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != filepath.Base(path) {
+		names := make([]string, len(entries))
+		for i, e := range entries {
+			names[i] = e.Name()
+		}
+		t.Errorf("directory holds %q, want only %s", names, filepath.Base(path))
+	}
+}
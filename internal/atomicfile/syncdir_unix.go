@@ -0,0 +1,15 @@
+//go:build !windows
+
+package atomicfile
+
+import "os"
+
+// syncDir flushes the directory entry changes (the rename) in dir to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir) //nolint:gosec // directory of a path the caller chose
+	if err != nil {
+		return err
+	}
+	defer d.Close() //nolint:errcheck // read-only handle
+	return syncFile(d)
+}
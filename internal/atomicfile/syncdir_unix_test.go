@@ -0,0 +1,29 @@
+//go:build !windows
+
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteSyncsDirectoryAfterRename(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.md")
+
+	var synced []string
+	syncFile = func(f *os.File) error {
+		if _, err := os.Stat(path); err == nil {
+			synced = append(synced, f.Name())
+		}
+		return f.Sync()
+	}
+	t.Cleanup(func() { syncFile = (*os.File).Sync })
+
+	if err := Write(path, []byte("data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if len(synced) != 1 || synced[0] != filepath.Dir(path) {
+		t.Errorf("synced after rename: %q, want the directory", synced)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package atomicfile
+
+// syncDir is a no-op on Windows, where directories cannot be fsynced and
+// NTFS journals the rename itself.
+func syncDir(string) error {
+	return nil
+}
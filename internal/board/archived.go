@@ -0,0 +1,32 @@
+package board
+
+import (
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+)
+
+// ArchivedMode controls whether read commands show archived tasks.
+type ArchivedMode int
+
+const (
+	// ArchivedDefault hides archived tasks unless statuses are filtered
+	// explicitly (e.g. list --status archived).
+	ArchivedDefault ArchivedMode = iota
+	// ArchivedInclude shows archived tasks alongside all others.
+	ArchivedInclude
+	// ArchivedOnly shows only archived tasks.
+	ArchivedOnly
+)
+
+// ApplyArchived adjusts opts so that archived tasks are included according to
+// mode. Every read command decides archived visibility through this function.
+func ApplyArchived(opts *FilterOptions, mode ArchivedMode) {
+	switch mode {
+	case ArchivedOnly:
+		opts.Statuses = []string{config.ArchivedStatus}
+	case ArchivedInclude:
+	default:
+		if len(opts.Statuses) == 0 {
+			opts.ExcludeStatuses = append(opts.ExcludeStatuses, config.ArchivedStatus)
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package board
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,11 +19,13 @@ type ListOptions struct {
 	Filter    FilterOptions
 	SortBy    string
 	Reverse   bool
+	Offset    int // number of sorted results to skip before Limit applies
 	Limit     int
 	Unblocked bool // only tasks with all dependencies at terminal status
 }
 
-// List loads all tasks, applies filters and sorting.
+// List loads all tasks, applies filters and sorting, then pages the result
+// with Offset and Limit.
 // Uses lenient parsing: malformed task files are skipped and returned as warnings.
 func List(cfg *config.Config, opts ListOptions) ([]*task.Task, []task.ReadWarning, error) {
 	allTasks, warnings, err := task.ReadAllLenient(cfg.TasksPath())
@@ -39,8 +44,13 @@ func List(cfg *config.Config, opts ListOptions) ([]*task.Task, []task.ReadWarnin
 	if sortField == "" {
 		sortField = "id"
 	}
-	Sort(tasks, sortField, opts.Reverse, cfg)
+	if err := Sort(tasks, sortField, opts.Reverse, cfg); err != nil {
+		return nil, nil, err
+	}
 
+	if opts.Offset > 0 {
+		tasks = tasks[min(opts.Offset, len(tasks)):]
+	}
 	if opts.Limit > 0 && len(tasks) > opts.Limit {
 		tasks = tasks[:opts.Limit]
 	}
@@ -61,16 +71,24 @@ func FindDependents(tasksDir string, id int) []string {
 		if t.Parent != nil && *t.Parent == id {
 			msgs = append(msgs, fmt.Sprintf("task #%d (%s) has this as parent", t.ID, t.Title))
 		}
-		for _, dep := range t.DependsOn {
-			if dep == id {
-				msgs = append(msgs, fmt.Sprintf("task #%d (%s) depends on this task", t.ID, t.Title))
-				break
-			}
+		if slices.Contains(t.DependsOn, id) {
+			msgs = append(msgs, fmt.Sprintf("task #%d (%s) depends on this task", t.ID, t.Title))
 		}
 	}
 	return msgs
 }
 
+// Dependents returns the tasks that list id in their DependsOn, in input order.
+func Dependents(tasks []*task.Task, id int) []*task.Task {
+	var deps []*task.Task
+	for _, t := range tasks {
+		if slices.Contains(t.DependsOn, id) {
+			deps = append(deps, t)
+		}
+	}
+	return deps
+}
+
 // StatusSummary holds metrics for a single status column.
 type StatusSummary struct {
 	Status   string `json:"status"`
@@ -78,6 +96,27 @@ type StatusSummary struct {
 	WIPLimit int    `json:"wip_limit,omitempty"`
 	Blocked  int    `json:"blocked"`
 	Overdue  int    `json:"overdue"`
+	OverWIP  bool   `json:"over_wip,omitempty"` // Count exceeds WIPLimit
+	// WIPMinimum is the replenishment threshold; UnderMin is set when Count
+	// is below it.
+	WIPMinimum int  `json:"wip_minimum,omitempty"`
+	UnderMin   bool `json:"under_min,omitempty"`
+	AtRisk     int  `json:"at_risk,omitempty"` // tasks in their class escalation window
+
+	// OldestAge and AvgAge measure how long tasks have been in this status.
+	// They are only computed with SummaryOptions.FlowMetrics.
+	OldestAge time.Duration `json:"-"`
+	AvgAge    time.Duration `json:"-"`
+}
+
+// MarshalJSON adds the age metrics as whole seconds.
+func (s StatusSummary) MarshalJSON() ([]byte, error) {
+	type plain StatusSummary
+	return json.Marshal(struct {
+		plain
+		OldestAgeSeconds int64 `json:"oldest_age_seconds,omitempty"`
+		AvgAgeSeconds    int64 `json:"avg_age_seconds,omitempty"`
+	}{plain(s), int64(s.OldestAge.Seconds()), int64(s.AvgAge.Seconds())})
 }
 
 // PriorityCount holds a count for a priority level.
@@ -101,15 +140,29 @@ type Overview struct {
 	Classes    []ClassCount    `json:"classes,omitempty"`
 }
 
+// SummaryOptions controls optional Summary work.
+type SummaryOptions struct {
+	Now time.Time
+	// FlowMetrics computes per-status time-in-status ages. It reads the
+	// activity log, so callers that only need counts leave it off.
+	FlowMetrics bool
+}
+
 // Summary computes a board summary from all tasks.
-// It uses BoardStatuses() to exclude the archived column from display.
-func Summary(cfg *config.Config, tasks []*task.Task, now time.Time) Overview {
+// It uses BoardStatuses() for the columns, adding the archived column only
+// when archived tasks are passed in (board --include-archived).
+func Summary(cfg *config.Config, tasks []*task.Task, opts SummaryOptions) Overview {
+	now := opts.Now
 	displayStatuses := cfg.BoardStatuses()
+	if slices.ContainsFunc(tasks, func(t *task.Task) bool { return cfg.IsArchivedStatus(t.Status) }) {
+		displayStatuses = append(displayStatuses, config.ArchivedStatus)
+	}
 	statusMap := make(map[string]*StatusSummary, len(displayStatuses))
 	for _, s := range displayStatuses {
 		statusMap[s] = &StatusSummary{
-			Status:   s,
-			WIPLimit: cfg.WIPLimit(s),
+			Status:     s,
+			WIPLimit:   cfg.WIPLimit(s),
+			WIPMinimum: cfg.WIPMinimum(s),
 		}
 	}
 
@@ -122,9 +175,12 @@ func Summary(cfg *config.Config, tasks []*task.Task, now time.Time) Overview {
 			if t.Blocked {
 				ss.Blocked++
 			}
-			if t.Due != nil && t.Due.Before(now) && !cfg.IsTerminalStatus(t.Status) {
+			if isOverdue(t, now, cfg.IsTerminalStatus(t.Status)) {
 				ss.Overdue++
 			}
+			if IsAtRisk(cfg, t, now) {
+				ss.AtRisk++
+			}
 		}
 		prioMap[t.Priority]++
 		cls := t.Class
@@ -134,13 +190,20 @@ func Summary(cfg *config.Config, tasks []*task.Task, now time.Time) Overview {
 		classMap[cls]++
 	}
 
+	if opts.FlowMetrics {
+		applyStatusAges(cfg, tasks, statusMap, now)
+	}
+
 	statuses := make([]StatusSummary, 0, len(displayStatuses))
 	for _, s := range displayStatuses {
-		statuses = append(statuses, *statusMap[s])
+		ss := statusMap[s]
+		ss.OverWIP = ss.WIPLimit > 0 && ss.Count > ss.WIPLimit
+		ss.UnderMin = ss.WIPMinimum > 0 && ss.Count < ss.WIPMinimum
+		statuses = append(statuses, *ss)
 	}
 
 	priorities := make([]PriorityCount, 0, len(cfg.Priorities))
-	for _, p := range cfg.Priorities {
+	for _, p := range cfg.PriorityNames() {
 		priorities = append(priorities, PriorityCount{Priority: p, Count: prioMap[p]})
 	}
 
@@ -161,6 +224,104 @@ func Summary(cfg *config.Config, tasks []*task.Task, now time.Time) Overview {
 	}
 }
 
+// AssigneeOverview is the board overview restricted to one assignee's tasks.
+type AssigneeOverview struct {
+	Assignee string   `json:"assignee"`
+	Overview Overview `json:"overview"`
+}
+
+// SummaryByAssignee computes a Summary per assignee, sorted by name with
+// unassigned tasks last. Column WIP limits and minimums are board-wide, so
+// they are left out of the per-assignee overviews.
+func SummaryByAssignee(cfg *config.Config, tasks []*task.Task, opts SummaryOptions) []AssigneeOverview {
+	byAssignee := make(map[string][]*task.Task)
+	for _, t := range tasks {
+		byAssignee[t.Assignee] = append(byAssignee[t.Assignee], t)
+	}
+	names := slices.Sorted(maps.Keys(byAssignee))
+	if len(names) > 0 && names[0] == "" {
+		names = append(names[1:], "")
+	}
+
+	result := make([]AssigneeOverview, 0, len(names))
+	for _, name := range names {
+		ov := Summary(cfg, byAssignee[name], opts)
+		for i := range ov.Statuses {
+			ss := &ov.Statuses[i]
+			ss.WIPLimit, ss.OverWIP = 0, false
+			ss.WIPMinimum, ss.UnderMin = 0, false
+		}
+		label := name
+		if label == "" {
+			label = "(unassigned)"
+		}
+		result = append(result, AssigneeOverview{Assignee: label, Overview: ov})
+	}
+	return result
+}
+
+// applyStatusAges fills OldestAge and AvgAge. A task entered its status at
+// its latest logged move into that status, or at Updated when the log has
+// none (e.g. created there, or the entry was truncated away).
+func applyStatusAges(cfg *config.Config, tasks []*task.Task, statusMap map[string]*StatusSummary, now time.Time) {
+	log, _ := ReadLog(cfg.Dir()) // a missing or unreadable log falls back to Updated
+	entered := make(map[int]map[string]time.Time)
+	for _, e := range log {
+		if e.Action != "move" {
+			continue
+		}
+		if _, to := parseMoveDetail(e.Detail); to != "" {
+			if entered[e.TaskID] == nil {
+				entered[e.TaskID] = make(map[string]time.Time)
+			}
+			entered[e.TaskID][to] = e.Timestamp
+		}
+	}
+
+	totals := make(map[string]time.Duration)
+	for _, t := range tasks {
+		ss, ok := statusMap[t.Status]
+		if !ok {
+			continue
+		}
+		since, ok := entered[t.ID][t.Status]
+		if !ok || since.Before(t.Created) {
+			since = t.Updated
+		}
+		age := max(now.Sub(since), 0)
+		ss.OldestAge = max(ss.OldestAge, age)
+		totals[t.Status] += age
+	}
+	for s, total := range totals {
+		if ss := statusMap[s]; ss.Count > 0 {
+			ss.AvgAge = total / time.Duration(ss.Count)
+		}
+	}
+}
+
+// OverWIP returns the status columns whose task count exceeds their WIP limit.
+func (o Overview) OverWIP() []StatusSummary {
+	var over []StatusSummary
+	for _, ss := range o.Statuses {
+		if ss.OverWIP {
+			over = append(over, ss)
+		}
+	}
+	return over
+}
+
+// UnderMin returns the statuses whose count is below their replenishment
+// minimum.
+func (o Overview) UnderMin() []StatusSummary {
+	var under []StatusSummary
+	for _, ss := range o.Statuses {
+		if ss.UnderMin {
+			under = append(under, ss)
+		}
+	}
+	return under
+}
+
 // ParseIDs splits a comma-separated ID string into deduplicated int IDs.
 func ParseIDs(arg string) ([]int, error) {
 	parts := strings.Split(arg, ",")
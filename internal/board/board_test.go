@@ -0,0 +1,70 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestSummaryFlowMetricsAges(t *testing.T) {
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+
+	cfg, err := config.Init(t.TempDir(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range []LogEntry{
+		{Timestamp: at(0), Action: "move", TaskID: 1, Detail: "backlog -> in-progress"},
+		{Timestamp: at(1), Action: "move", TaskID: 1, Detail: "in-progress -> review"},
+		{Timestamp: at(2), Action: "move", TaskID: 1, Detail: "review -> in-progress"},
+		{Timestamp: at(3), Action: "edit", TaskID: 1, Detail: "title"},
+		{Timestamp: at(1), Action: "move", TaskID: 3, Detail: "todo -> in-progress"},
+	} {
+		if err := AppendLog(cfg.Dir(), e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tasks := []*task.Task{
+		// Latest logged move into the status wins: 10h - 2h.
+		{ID: 1, Status: "in-progress", Created: at(0), Updated: at(3)},
+		// No logged move falls back to Updated: 10h - 4h.
+		{ID: 2, Status: "in-progress", Created: at(2), Updated: at(4)},
+		// A move logged before the task was created (a reused ID) is ignored: 10h - 7h.
+		{ID: 3, Status: "in-progress", Created: at(3), Updated: at(7)},
+		// Ages never go negative.
+		{ID: 4, Status: "todo", Created: at(0), Updated: at(12)},
+	}
+	now := at(10)
+
+	tests := []struct {
+		name       string
+		flow       bool
+		status     string
+		wantOldest time.Duration
+		wantAvg    time.Duration
+	}{
+		{"in progress", true, "in-progress", 8 * time.Hour, 17 * time.Hour / 3},
+		{"clamped", true, "todo", 0, 0},
+		{"empty column", true, "review", 0, 0},
+		{"without flow metrics", false, "in-progress", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ov := Summary(cfg, tasks, SummaryOptions{Now: now, FlowMetrics: tt.flow})
+			for _, ss := range ov.Statuses {
+				if ss.Status != tt.status {
+					continue
+				}
+				if ss.OldestAge != tt.wantOldest || ss.AvgAge != tt.wantAvg {
+					t.Errorf("%s ages = oldest %v, avg %v; want %v, %v",
+						ss.Status, ss.OldestAge, ss.AvgAge, tt.wantOldest, tt.wantAvg)
+				}
+				return
+			}
+			t.Fatalf("no %s column in summary", tt.status)
+		})
+	}
+}
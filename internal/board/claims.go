@@ -0,0 +1,47 @@
+package board
+
+import (
+	"sort"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// Claim is an unexpired claim on a task.
+type Claim struct {
+	*task.Task
+
+	// ExpiresAt is when the claim lapses; nil when claims do not expire.
+	ExpiresAt *time.Time `json:"claim_expires_at,omitempty"`
+	// RemainingSeconds is the time left on the claim, when it expires.
+	RemainingSeconds *int64 `json:"claim_remaining_seconds,omitempty"`
+}
+
+// Remaining returns the time left on the claim at now, and false when the
+// claim does not expire.
+func (c Claim) Remaining(now time.Time) (time.Duration, bool) {
+	if c.ExpiresAt == nil {
+		return 0, false
+	}
+	return c.ExpiresAt.Sub(now), true
+}
+
+// ClaimsBy returns the tasks claimed by name whose claims have not expired
+// under timeout (see IsUnclaimed), sorted by ID.
+func ClaimsBy(tasks []*task.Task, name string, timeout time.Duration, now time.Time) []Claim {
+	claims := []Claim{}
+	for _, t := range tasks {
+		if t.ClaimedBy != name || IsUnclaimed(t, timeout) {
+			continue
+		}
+		c := Claim{Task: t}
+		if timeout > 0 && t.ClaimedAt != nil {
+			expires := t.ClaimedAt.Add(timeout)
+			secs := int64(expires.Sub(now).Seconds())
+			c.ExpiresAt, c.RemainingSeconds = &expires, &secs
+		}
+		claims = append(claims, c)
+	}
+	sort.Slice(claims, func(i, j int) bool { return claims[i].ID < claims[j].ID })
+	return claims
+}
@@ -0,0 +1,80 @@
+package board
+
+import (
+	"slices"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// DepNode is one task in a dependency tree.
+type DepNode struct {
+	ID       int        `json:"id"`
+	Title    string     `json:"title,omitempty"`
+	Status   string     `json:"status,omitempty"`
+	Done     bool       `json:"done"`
+	Missing  bool       `json:"missing,omitempty"` // referenced task does not exist
+	Cycle    bool       `json:"cycle,omitempty"`   // task already appears above this node
+	Children []*DepNode `json:"children,omitempty"`
+}
+
+// DependencyTree builds the tree of tasks that rootID depends on, recursively.
+// With reverse, it follows the other direction: tasks that depend on rootID.
+// depth limits the levels below the root (0 means unlimited). A task that
+// already appears on the path from the root is emitted once more with Cycle
+// set and not expanded. Returns nil if rootID is not in tasks.
+func DependencyTree(cfg *config.Config, tasks []*task.Task, rootID int, reverse bool, depth int) *DepNode {
+	byID := make(map[int]*task.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	root, ok := byID[rootID]
+	if !ok {
+		return nil
+	}
+
+	next := func(t *task.Task) []int {
+		if !reverse {
+			return t.DependsOn
+		}
+		var ids []int
+		for _, d := range Dependents(tasks, t.ID) {
+			ids = append(ids, d.ID)
+		}
+		return ids
+	}
+
+	var build func(t *task.Task, path []int, level int) *DepNode
+	build = func(t *task.Task, path []int, level int) *DepNode {
+		n := depNode(cfg, t)
+		if depth > 0 && level >= depth {
+			return n
+		}
+		path = append(path, t.ID)
+		for _, id := range next(t) {
+			child, ok := byID[id]
+			switch {
+			case !ok:
+				n.Children = append(n.Children, &DepNode{ID: id, Missing: true})
+			case slices.Contains(path, id):
+				c := depNode(cfg, child)
+				c.Cycle = true
+				n.Children = append(n.Children, c)
+			default:
+				n.Children = append(n.Children, build(child, path, level+1))
+			}
+		}
+		return n
+	}
+
+	return build(root, nil, 0)
+}
+
+func depNode(cfg *config.Config, t *task.Task) *DepNode {
+	return &DepNode{
+		ID:     t.ID,
+		Title:  t.Title,
+		Status: t.Status,
+		Done:   cfg.IsTerminalStatus(t.Status),
+	}
+}
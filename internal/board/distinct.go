@@ -0,0 +1,46 @@
+package board
+
+import (
+	"sort"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// ValueCount is a distinct field value with the number of tasks that have it.
+type ValueCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// DistinctAssignees returns the distinct non-empty assignees across tasks,
+// most frequent first.
+func DistinctAssignees(tasks []*task.Task) []ValueCount {
+	return distinctValues(tasks, func(t *task.Task) string { return t.Assignee })
+}
+
+// DistinctClaimants returns the distinct non-empty claimants across tasks,
+// most frequent first. Expired claims are included.
+func DistinctClaimants(tasks []*task.Task) []ValueCount {
+	return distinctValues(tasks, func(t *task.Task) string { return t.ClaimedBy })
+}
+
+func distinctValues(tasks []*task.Task, field func(*task.Task) string) []ValueCount {
+	counts := make(map[string]int)
+	for _, t := range tasks {
+		if v := field(t); v != "" {
+			counts[v]++
+		}
+	}
+
+	values := make([]ValueCount, 0, len(counts))
+	for v, n := range counts {
+		values = append(values, ValueCount{Value: v, Count: n})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if values[i].Count != values[j].Count {
+			return values[i].Count > values[j].Count
+		}
+		return values[i].Value < values[j].Value
+	})
+	return values
+}
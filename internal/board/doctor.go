@@ -0,0 +1,305 @@
+package board
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// Doctor check categories.
+const (
+	CheckConfig       = "config"
+	CheckNextID       = "next_id"
+	CheckDuplicateID  = "duplicate_id"
+	CheckFilename     = "filename"
+	CheckUnknownValue = "unknown_value"
+	CheckDanglingRef  = "dangling_ref"
+	CheckUnparseable  = "unparseable"
+	CheckStaleSession = "stale_session"
+)
+
+// Problem is one integrity issue found by Diagnose.
+type Problem struct {
+	Check   string `json:"check"`
+	TaskID  int    `json:"task_id,omitempty"`
+	File    string `json:"file,omitempty"`
+	Message string `json:"message"`
+	Fixable bool   `json:"fixable"`
+	Fixed   bool   `json:"fixed,omitempty"`
+	Error   string `json:"error,omitempty"` // set when a fix was attempted and failed
+
+	fix func() error
+}
+
+// taskFile is a parsed task together with the ID in its filename.
+type taskFile struct {
+	task   *task.Task
+	name   string
+	fileID int // -1 when the filename has no ID prefix
+}
+
+// Diagnose checks board integrity: config validity, next_id, duplicate and
+// mismatched IDs, unknown field values, dangling references, unparseable
+// files and session files for tasks that are gone or finished. cfgErr is the
+// result of validating cfg, reported as a config problem. Lock files are not
+// checked: they are released by the OS when their holder exits, so they are
+// never stale.
+func Diagnose(cfg *config.Config, cfgErr error) ([]Problem, error) {
+	var problems []Problem
+	if cfgErr != nil {
+		problems = append(problems, Problem{Check: CheckConfig, File: config.ConfigFileName, Message: cfgErr.Error()})
+	}
+
+	files, unparseable, err := readTaskFiles(cfg.TasksPath(), cfg.FilenameFormat())
+	if err != nil {
+		return nil, err
+	}
+	problems = append(problems, unparseable...)
+
+	byID := make(map[int][]taskFile)
+	maxID := 0
+	for _, f := range files {
+		byID[f.task.ID] = append(byID[f.task.ID], f)
+		maxID = max(maxID, f.task.ID, f.fileID)
+	}
+
+	problems = append(problems, checkNextID(cfg, maxID)...)
+	problems = append(problems, checkDuplicateIDs(byID)...)
+	for _, f := range files {
+		problems = append(problems, checkFilename(cfg, f, byID)...)
+		problems = append(problems, checkTaskValues(cfg, f.task)...)
+		problems = append(problems, checkDanglingRefs(f.task, byID)...)
+	}
+	problems = append(problems, checkSessions(cfg, byID)...)
+	return problems, nil
+}
+
+// Repair runs the fix for every fixable problem, marking it fixed or
+// recording the error. The caller must hold the board lock.
+func Repair(problems []Problem) {
+	for i := range problems {
+		p := &problems[i]
+		if p.fix == nil {
+			continue
+		}
+		if err := p.fix(); err != nil {
+			p.Error = err.Error()
+			continue
+		}
+		p.Fixed = true
+	}
+}
+
+func readTaskFiles(tasksDir string, format *config.FilenameFormat) ([]taskFile, []Problem, error) {
+	entries, err := os.ReadDir(tasksDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("reading tasks directory: %w", err)
+	}
+
+	var files []taskFile
+	var problems []Problem
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		t, readErr := task.Read(filepath.Join(tasksDir, entry.Name()))
+		if readErr != nil {
+			problems = append(problems, Problem{
+				Check: CheckUnparseable, File: entry.Name(), Message: readErr.Error(),
+			})
+			continue
+		}
+		fileID, ok := format.ExtractID(entry.Name())
+		if !ok {
+			var idErr error
+			if fileID, idErr = task.ExtractIDFromFilename(entry.Name()); idErr != nil {
+				fileID = -1
+			}
+		}
+		files = append(files, taskFile{task: t, name: entry.Name(), fileID: fileID})
+	}
+	return files, problems, nil
+}
+
+func checkNextID(cfg *config.Config, maxID int) []Problem {
+	if cfg.NextID > maxID {
+		return nil
+	}
+	return []Problem{{
+		Check:   CheckNextID,
+		File:    config.ConfigFileName,
+		Message: fmt.Sprintf("next_id %d is not above the highest task ID %d", cfg.NextID, maxID),
+		Fixable: true,
+		fix: func() error {
+			cfg.NextID = maxID + 1
+			return cfg.Save()
+		},
+	}}
+}
+
+func checkDuplicateIDs(byID map[int][]taskFile) []Problem {
+	ids := make([]int, 0, len(byID))
+	for id, fs := range byID {
+		if len(fs) > 1 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	problems := make([]Problem, 0, len(ids))
+	for _, id := range ids {
+		names := make([]string, 0, len(byID[id]))
+		for _, f := range byID[id] {
+			names = append(names, f.name)
+		}
+		problems = append(problems, Problem{
+			Check:   CheckDuplicateID,
+			TaskID:  id,
+			Message: fmt.Sprintf("ID used by %d files: %s", len(names), strings.Join(names, ", ")),
+		})
+	}
+	return problems
+}
+
+// checkFilename reports files whose name prefix disagrees with the
+// frontmatter ID. The frontmatter wins: the file is renamed unless another
+// file already holds that ID.
+func checkFilename(cfg *config.Config, f taskFile, byID map[int][]taskFile) []Problem {
+	if f.fileID == f.task.ID {
+		return nil
+	}
+	p := Problem{
+		Check:   CheckFilename,
+		TaskID:  f.task.ID,
+		File:    f.name,
+		Message: fmt.Sprintf("filename does not match frontmatter ID %d", f.task.ID),
+	}
+	if len(byID[f.task.ID]) > 1 {
+		return []Problem{p}
+	}
+
+	target := task.FilenameFor(cfg, f.task)
+	p.Message += "; rename to " + target
+	p.Fixable = true
+	p.fix = func() error {
+		dst := filepath.Join(cfg.TasksPath(), target)
+		if _, err := os.Stat(dst); err == nil {
+			return fmt.Errorf("%s already exists", target)
+		}
+		if err := os.Rename(filepath.Join(cfg.TasksPath(), f.name), dst); err != nil {
+			return err
+		}
+		f.task.File = dst
+		return nil
+	}
+	return []Problem{p}
+}
+
+func checkTaskValues(cfg *config.Config, t *task.Task) []Problem {
+	var problems []Problem
+	unknown := func(field, value string) {
+		problems = append(problems, Problem{
+			Check:   CheckUnknownValue,
+			TaskID:  t.ID,
+			File:    filepath.Base(t.File),
+			Message: fmt.Sprintf("unknown %s %q", field, value),
+		})
+	}
+	if !slices.Contains(cfg.StatusNames(), t.Status) {
+		unknown("status", t.Status)
+	}
+	if !slices.Contains(cfg.PriorityNames(), t.Priority) {
+		unknown("priority", t.Priority)
+	}
+	if t.Class != "" && len(cfg.Classes) > 0 && !slices.Contains(cfg.ClassNames(), t.Class) {
+		unknown("class", t.Class)
+	}
+	return problems
+}
+
+// checkDanglingRefs reports parent and dependency IDs that match no task
+// file. The fix clears them.
+func checkDanglingRefs(t *task.Task, byID map[int][]taskFile) []Problem {
+	var missingDeps []int
+	for _, dep := range t.DependsOn {
+		if _, ok := byID[dep]; !ok {
+			missingDeps = append(missingDeps, dep)
+		}
+	}
+	missingParent := t.Parent != nil && len(byID[*t.Parent]) == 0
+	if len(missingDeps) == 0 && !missingParent {
+		return nil
+	}
+
+	var parts []string
+	if missingParent {
+		parts = append(parts, "parent #"+strconv.Itoa(*t.Parent))
+	}
+	for _, dep := range missingDeps {
+		parts = append(parts, "dependency #"+strconv.Itoa(dep))
+	}
+	return []Problem{{
+		Check:   CheckDanglingRef,
+		TaskID:  t.ID,
+		File:    filepath.Base(t.File),
+		Message: "references missing " + strings.Join(parts, ", "),
+		Fixable: true,
+		fix: func() error {
+			if missingParent {
+				t.Parent = nil
+			}
+			t.DependsOn = slices.DeleteFunc(t.DependsOn, func(id int) bool {
+				return slices.Contains(missingDeps, id)
+			})
+			return task.Write(t.File, t)
+		},
+	}}
+}
+
+// checkSessions reports terminal session files (.sessions/<id>.iterm) for
+// tasks that no longer exist or are in a terminal status. The fix removes them.
+func checkSessions(cfg *config.Config, byID map[int][]taskFile) []Problem {
+	dir := filepath.Join(cfg.Dir(), ".sessions")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var problems []Problem
+	for _, entry := range entries {
+		idStr, ok := strings.CutSuffix(entry.Name(), ".iterm")
+		id, convErr := strconv.Atoi(idStr)
+		if entry.IsDir() || !ok || convErr != nil {
+			continue
+		}
+		var reason string
+		switch fs := byID[id]; {
+		case len(fs) == 0:
+			reason = "task not found"
+		case cfg.IsTerminalStatus(fs[0].task.Status):
+			reason = "task is " + fs[0].task.Status
+		default:
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		problems = append(problems, Problem{
+			Check:   CheckStaleSession,
+			TaskID:  id,
+			File:    filepath.Join(".sessions", entry.Name()),
+			Message: "stale session file (" + reason + ")",
+			Fixable: true,
+			fix:     func() error { return os.Remove(path) },
+		})
+	}
+	return problems
+}
@@ -2,10 +2,13 @@
 package board
 
 import (
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
 
@@ -15,14 +18,37 @@ type FilterOptions struct {
 	ExcludeStatuses []string // statuses to exclude from results
 	Priorities      []string
 	Assignee        string
-	Tag             string
-	Search          string        // case-insensitive substring match across title, body, and tags
-	Blocked         *bool         // nil=no filter, true=only blocked, false=only not-blocked
-	ParentID        *int          // nil=no filter, non-nil=only tasks with this parent
-	Unclaimed       bool          // only unclaimed or expired-claim tasks
-	ClaimedBy       string        // filter to specific claimant
-	ClaimTimeout    time.Duration // claim expiration for unclaimed filter
-	Class           string        // filter by class of service
+	Unassigned      bool           // only tasks without an assignee
+	Tags            []string       // tasks must carry every one of these tags
+	AnyTags         []string       // tasks must carry at least one of these tags
+	ExcludeTags     []string       // tasks must carry none of these tags
+	NoTags          bool           // only tasks without any tags
+	HasLinks        []string       // tasks must have a link with every one of these rels
+	NoLinks         []string       // tasks must have no link with any of these rels
+	Search          string         // substring match across title, body, and tags; supports title:/body:/tag: terms
+	SearchRegex     *regexp.Regexp // pattern matched against title, body, and tags
+	CaseSensitive   bool           // make Search case-sensitive
+	Blocked         *bool          // nil=no filter, true=only blocked, false=only not-blocked
+	ParentID        *int           // nil=no filter, non-nil=only tasks with this parent
+	DependsOnID     *int           // nil=no filter, non-nil=only tasks depending on this ID
+	IDs             []int          // nil=no filter, non-nil (even empty)=only these task IDs
+	Unclaimed       bool           // only unclaimed or expired-claim tasks
+	ClaimedBy       string         // filter to specific claimant
+	ClaimTimeout    time.Duration  // claim expiration for unclaimed filter
+	Class           string         // filter by class of service
+
+	// Time windows: Since bounds are inclusive, Until bounds are exclusive.
+	CreatedSince *time.Time
+	CreatedUntil *time.Time
+	UpdatedSince *time.Time
+	UpdatedUntil *time.Time
+
+	// Due-date filters. Tasks without a due date never match them.
+	DueBefore        *date.Date // only tasks due strictly before this date
+	DueAfter         *date.Date // only tasks due strictly after this date
+	Overdue          bool       // only tasks past their due date
+	HasDue           *bool      // nil=no filter, true=only with a due date, false=only without
+	TerminalStatuses []string   // statuses never considered overdue
 }
 
 // Filter returns tasks matching all specified criteria (AND logic).
@@ -40,9 +66,52 @@ func matchesFilter(t *task.Task, opts FilterOptions) bool {
 	if !matchesCoreFilter(t, opts) {
 		return false
 	}
+	if !matchesTimeFilter(t, opts) {
+		return false
+	}
 	return matchesExtendedFilter(t, opts)
 }
 
+// matchesTags applies the tag filters: all of Tags, at least one of AnyTags,
+// none of ExcludeTags, and no tags at all with NoTags.
+func matchesTags(tags []string, opts FilterOptions) bool {
+	if opts.NoTags && len(tags) > 0 {
+		return false
+	}
+	for _, tag := range opts.Tags {
+		if !containsStr(tags, tag) {
+			return false
+		}
+	}
+	if len(opts.AnyTags) > 0 && !slices.ContainsFunc(opts.AnyTags, func(tag string) bool {
+		return containsStr(tags, tag)
+	}) {
+		return false
+	}
+	for _, tag := range opts.ExcludeTags {
+		if containsStr(tags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesLinks applies the link filters: a link for every rel in HasLinks
+// and none for the rels in NoLinks.
+func matchesLinks(t *task.Task, opts FilterOptions) bool {
+	for _, rel := range opts.HasLinks {
+		if !task.HasLinkRel(t, rel) {
+			return false
+		}
+	}
+	for _, rel := range opts.NoLinks {
+		if task.HasLinkRel(t, rel) {
+			return false
+		}
+	}
+	return true
+}
+
 func matchesCoreFilter(t *task.Task, opts FilterOptions) bool {
 	if !matchesStatus(t.Status, opts.Statuses, opts.ExcludeStatuses) {
 		return false
@@ -53,18 +122,49 @@ func matchesCoreFilter(t *task.Task, opts FilterOptions) bool {
 	if opts.Assignee != "" && t.Assignee != opts.Assignee {
 		return false
 	}
-	if opts.Tag != "" && !containsStr(t.Tags, opts.Tag) {
+	if opts.Unassigned && t.Assignee != "" {
+		return false
+	}
+	if opts.HasDue != nil && (t.Due != nil) != *opts.HasDue {
+		return false
+	}
+	if !matchesTags(t.Tags, opts) {
+		return false
+	}
+	if !matchesLinks(t, opts) {
 		return false
 	}
 	if opts.Blocked != nil && t.Blocked != *opts.Blocked {
 		return false
 	}
+	if opts.DependsOnID != nil && !slices.Contains(t.DependsOn, *opts.DependsOnID) {
+		return false
+	}
+	if opts.IDs != nil && !slices.Contains(opts.IDs, t.ID) {
+		return false
+	}
 	if opts.ParentID != nil && (t.Parent == nil || *t.Parent != *opts.ParentID) {
 		return false
 	}
 	return true
 }
 
+func matchesTimeFilter(t *task.Task, opts FilterOptions) bool {
+	return inWindow(t.Created, opts.CreatedSince, opts.CreatedUntil) &&
+		inWindow(t.Updated, opts.UpdatedSince, opts.UpdatedUntil)
+}
+
+// inWindow reports whether ts is within [since, until). Nil bounds are open.
+func inWindow(ts time.Time, since, until *time.Time) bool {
+	if since != nil && ts.Before(*since) {
+		return false
+	}
+	if until != nil && !ts.Before(*until) {
+		return false
+	}
+	return true
+}
+
 func matchesStatus(status string, include, exclude []string) bool {
 	if len(include) > 0 && !containsStr(include, status) {
 		return false
@@ -75,17 +175,77 @@ func matchesStatus(status string, include, exclude []string) bool {
 	return true
 }
 
-// matchesSearch performs case-insensitive substring matching across title, body, and tags.
-func matchesSearch(t *task.Task, query string) bool {
-	q := strings.ToLower(query)
-	if strings.Contains(strings.ToLower(t.Title), q) {
+// searchFields are the field prefixes recognized in scoped search terms.
+var searchFields = []string{"title", "body", "tag"}
+
+// searchTerm is one term of a search query; an empty field matches any field.
+type searchTerm struct {
+	field string
+	value string
+}
+
+// parseSearchQuery splits a query into terms. A query without any
+// "field:value" term is a single substring matched across all fields, so
+// plain queries keep their exact-phrase behavior. Otherwise each
+// whitespace-separated word is a term and all terms must match.
+func parseSearchQuery(query string) []searchTerm {
+	words := strings.Fields(query)
+	terms := make([]searchTerm, 0, len(words))
+	scoped := false
+	for _, w := range words {
+		field, value, ok := strings.Cut(w, ":")
+		if ok && value != "" && containsStr(searchFields, field) {
+			terms = append(terms, searchTerm{field: field, value: value})
+			scoped = true
+		} else {
+			terms = append(terms, searchTerm{value: w})
+		}
+	}
+	if !scoped {
+		return []searchTerm{{value: query}}
+	}
+	return terms
+}
+
+// matchesSearch performs substring matching across title, body, and tags,
+// honoring field-scoped terms (see parseSearchQuery).
+func matchesSearch(t *task.Task, query string, caseSensitive bool) bool {
+	fold := strings.ToLower
+	if caseSensitive {
+		fold = func(s string) string { return s }
+	}
+	for _, term := range parseSearchQuery(query) {
+		if !matchesSearchTerm(t, fold(term.value), term.field, fold) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesSearchTerm(t *task.Task, q, field string, fold func(string) string) bool {
+	if (field == "" || field == "title") && strings.Contains(fold(t.Title), q) {
 		return true
 	}
-	if strings.Contains(strings.ToLower(t.Body), q) {
+	if (field == "" || field == "body") && strings.Contains(fold(t.Body), q) {
+		return true
+	}
+	if field == "" || field == "tag" {
+		for _, tag := range t.Tags {
+			if strings.Contains(fold(tag), q) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesRegex reports whether re matches the title, body, or any tag.
+func matchesRegex(t *task.Task, re *regexp.Regexp) bool {
+	if re.MatchString(t.Title) || re.MatchString(t.Body) {
 		return true
 	}
 	for _, tag := range t.Tags {
-		if strings.Contains(strings.ToLower(tag), q) {
+		if re.MatchString(tag) {
 			return true
 		}
 	}
@@ -93,7 +253,10 @@ func matchesSearch(t *task.Task, query string) bool {
 }
 
 func matchesExtendedFilter(t *task.Task, opts FilterOptions) bool {
-	if opts.Search != "" && !matchesSearch(t, opts.Search) {
+	if opts.Search != "" && !matchesSearch(t, opts.Search, opts.CaseSensitive) {
+		return false
+	}
+	if opts.SearchRegex != nil && !matchesRegex(t, opts.SearchRegex) {
 		return false
 	}
 	if opts.Unclaimed && !IsUnclaimed(t, opts.ClaimTimeout) {
@@ -105,9 +268,45 @@ func matchesExtendedFilter(t *task.Task, opts FilterOptions) bool {
 	if opts.Class != "" && t.Class != opts.Class {
 		return false
 	}
+	return matchesDueFilter(t, opts)
+}
+
+func matchesDueFilter(t *task.Task, opts FilterOptions) bool {
+	if opts.DueBefore == nil && opts.DueAfter == nil && !opts.Overdue {
+		return true
+	}
+	if t.Due == nil {
+		return false
+	}
+	if opts.DueBefore != nil && !t.Due.Before(opts.DueBefore.Time) {
+		return false
+	}
+	if opts.DueAfter != nil && !t.Due.After(opts.DueAfter.Time) {
+		return false
+	}
+	if opts.Overdue && !isOverdue(t, time.Now(), containsStr(opts.TerminalStatuses, t.Status)) {
+		return false
+	}
 	return true
 }
 
+// isOverdue reports whether t is past its due date at now. Tasks in a
+// terminal status are never overdue.
+func isOverdue(t *task.Task, now time.Time, terminal bool) bool {
+	return t.Due != nil && t.Due.Before(now) && !terminal
+}
+
+// IsAtRisk reports whether a non-terminal task of a class with
+// escalate_before has entered its escalation window: due within that window
+// but not yet overdue.
+func IsAtRisk(cfg *config.Config, t *task.Task, now time.Time) bool {
+	window := cfg.ClassEscalation(t.Class)
+	if window == 0 || t.Due == nil || t.Due.Before(now) || cfg.IsTerminalStatus(t.Status) {
+		return false
+	}
+	return !now.Before(t.Due.Add(-window))
+}
+
 // IsUnclaimed returns true if the task has no active claim (unclaimed or expired).
 func IsUnclaimed(t *task.Task, timeout time.Duration) bool {
 	if t.ClaimedBy == "" {
@@ -0,0 +1,86 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestMatchesTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		opts FilterOptions
+		want bool
+	}{
+		{"no filter", []string{"a"}, FilterOptions{}, true},
+		{"no filter, untagged", nil, FilterOptions{}, true},
+		{"all present", []string{"a", "b", "c"}, FilterOptions{Tags: []string{"a", "b"}}, true},
+		{"all, one missing", []string{"a"}, FilterOptions{Tags: []string{"a", "b"}}, false},
+		{"any, one present", []string{"b"}, FilterOptions{AnyTags: []string{"a", "b"}}, true},
+		{"any, none present", []string{"c"}, FilterOptions{AnyTags: []string{"a", "b"}}, false},
+		{"any, untagged", nil, FilterOptions{AnyTags: []string{"a"}}, false},
+		{"exclude, absent", []string{"a"}, FilterOptions{ExcludeTags: []string{"x"}}, true},
+		{"exclude, present", []string{"a", "x"}, FilterOptions{ExcludeTags: []string{"x"}}, false},
+		{"exclude, untagged", nil, FilterOptions{ExcludeTags: []string{"x"}}, true},
+		{"all and any", []string{"a", "c"}, FilterOptions{Tags: []string{"a"}, AnyTags: []string{"b", "c"}}, true},
+		{"all but not any", []string{"a"}, FilterOptions{Tags: []string{"a"}, AnyTags: []string{"b", "c"}}, false},
+		{"any and exclude", []string{"b", "x"}, FilterOptions{AnyTags: []string{"a", "b"}, ExcludeTags: []string{"x"}}, false},
+		{"all, any and exclude", []string{"a", "b"}, FilterOptions{Tags: []string{"a"}, AnyTags: []string{"b"}, ExcludeTags: []string{"x"}}, true},
+		{"no tags, untagged", nil, FilterOptions{NoTags: true}, true},
+		{"no tags, tagged", []string{"a"}, FilterOptions{NoTags: true}, false},
+		{"no tags with exclude", nil, FilterOptions{NoTags: true, ExcludeTags: []string{"x"}}, true},
+		{"no tags with all", nil, FilterOptions{NoTags: true, Tags: []string{"a"}}, false},
+		{"case sensitive", []string{"A"}, FilterOptions{Tags: []string{"a"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesTags(tt.tags, tt.opts); got != tt.want {
+				t.Errorf("matchesTags(%q) = %v, want %v", tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesDueFilter(t *testing.T) {
+	past := date.New(2000, time.January, 1)
+	mid := date.New(2026, time.June, 15)
+	future := date.New(2999, time.December, 31)
+	yes, no := true, false
+
+	tests := []struct {
+		name   string
+		due    *date.Date
+		status string
+		opts   FilterOptions
+		want   bool
+	}{
+		{"no filter, no due", nil, "todo", FilterOptions{}, true},
+		{"no filter, due", &mid, "todo", FilterOptions{}, true},
+		{"before, no due", nil, "todo", FilterOptions{DueBefore: &future}, false},
+		{"after, no due", nil, "todo", FilterOptions{DueAfter: &past}, false},
+		{"overdue, no due", nil, "todo", FilterOptions{Overdue: true}, false},
+		{"before matches", &mid, "todo", FilterOptions{DueBefore: &future}, true},
+		{"before is exclusive", &mid, "todo", FilterOptions{DueBefore: &mid}, false},
+		{"after matches", &mid, "todo", FilterOptions{DueAfter: &past}, true},
+		{"after is exclusive", &mid, "todo", FilterOptions{DueAfter: &mid}, false},
+		{"window", &mid, "todo", FilterOptions{DueAfter: &past, DueBefore: &future}, true},
+		{"overdue", &past, "todo", FilterOptions{Overdue: true}, true},
+		{"not yet due", &future, "todo", FilterOptions{Overdue: true}, false},
+		{"terminal never overdue", &past, "done", FilterOptions{Overdue: true, TerminalStatuses: []string{"done"}}, false},
+		{"has due, no due", nil, "todo", FilterOptions{HasDue: &yes}, false},
+		{"has due, due", &mid, "todo", FilterOptions{HasDue: &yes}, true},
+		{"no due, no due", nil, "todo", FilterOptions{HasDue: &no}, true},
+		{"no due, due", &mid, "todo", FilterOptions{HasDue: &no}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk := &task.Task{ID: 1, Status: tt.status, Due: tt.due}
+			if got := matchesFilter(tk, tt.opts); got != tt.want {
+				t.Errorf("matchesFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
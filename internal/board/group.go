@@ -1,7 +1,11 @@
 package board
 
 import (
+	"fmt"
+	"math"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
@@ -10,6 +14,11 @@ import (
 const (
 	fieldPriority = "priority"
 	fieldStatus   = "status"
+	fieldParent   = "parent"
+	fieldDueWeek  = "due-week"
+
+	noParentKey   = "(no parent)"
+	noDueKey      = "(no due)"
 	classStandard = "standard"
 )
 
@@ -26,11 +35,21 @@ type GroupSummary struct {
 }
 
 // GroupBy groups tasks by the specified field and returns summaries per group.
-func GroupBy(tasks []*task.Task, field string, cfg *config.Config) GroupedSummary {
+// all is used to look up parent titles when grouping by parent, so parents
+// filtered out of tasks are still named; nil falls back to tasks.
+func GroupBy(tasks, all []*task.Task, field string, cfg *config.Config) GroupedSummary {
+	if all == nil {
+		all = tasks
+	}
+	byID := make(map[int]*task.Task, len(all))
+	for _, t := range all {
+		byID[t.ID] = t
+	}
+
 	groups := make(map[string][]*task.Task)
 
 	for _, t := range tasks {
-		keys := extractGroupKeys(t, field)
+		keys := extractGroupKeys(t, field, byID)
 		for _, key := range keys {
 			groups[key] = append(groups[key], t)
 		}
@@ -53,7 +72,7 @@ func GroupBy(tasks []*task.Task, field string, cfg *config.Config) GroupedSummar
 	return result
 }
 
-func extractGroupKeys(t *task.Task, field string) []string {
+func extractGroupKeys(t *task.Task, field string, byID map[int]*task.Task) []string {
 	switch field {
 	case "assignee":
 		if t.Assignee == "" {
@@ -75,6 +94,19 @@ func extractGroupKeys(t *task.Task, field string) []string {
 		return []string{t.Priority}
 	case fieldStatus:
 		return []string{t.Status}
+	case fieldParent:
+		if t.Parent == nil {
+			return []string{noParentKey}
+		}
+		if p, ok := byID[*t.Parent]; ok {
+			return []string{fmt.Sprintf("#%d %s", p.ID, p.Title)}
+		}
+		return []string{fmt.Sprintf("#%d", *t.Parent)}
+	case fieldDueWeek:
+		if t.Due == nil {
+			return []string{noDueKey}
+		}
+		return []string{isoWeekKey(t.Due.Time)}
 	default:
 		return []string{"(all)"}
 	}
@@ -99,12 +131,35 @@ func sortGroupKeys(groups map[string][]*task.Task, field string, cfg *config.Con
 		sort.SliceStable(keys, func(i, j int) bool {
 			return cfg.ClassIndex(keys[i]) < cfg.ClassIndex(keys[j])
 		})
+	case fieldParent:
+		sort.SliceStable(keys, func(i, j int) bool {
+			return parentKeyID(keys[i]) < parentKeyID(keys[j])
+		})
+	case fieldDueWeek:
+		// ISO week keys sort chronologically; "(no due)" goes last.
+		sort.SliceStable(keys, func(i, j int) bool {
+			if (keys[i] == noDueKey) != (keys[j] == noDueKey) {
+				return keys[j] == noDueKey
+			}
+			return keys[i] < keys[j]
+		})
 	default:
 		sort.Strings(keys)
 	}
 	return keys
 }
 
+// parentKeyID extracts the parent ID from a "#ID title" group key. The
+// "(no parent)" key sorts after every parent.
+func parentKeyID(key string) int {
+	idStr, _, _ := strings.Cut(strings.TrimPrefix(key, "#"), " ")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return math.MaxInt
+	}
+	return id
+}
+
 func groupStatusSummary(tasks []*task.Task, cfg *config.Config) []StatusSummary {
 	counts := make(map[string]int)
 	for _, t := range tasks {
@@ -124,5 +179,5 @@ func groupStatusSummary(tasks []*task.Task, cfg *config.Config) []StatusSummary
 
 // ValidGroupByFields returns the list of valid --group-by field names.
 func ValidGroupByFields() []string {
-	return []string{"assignee", "tag", "class", "priority", "status"}
+	return []string{"assignee", "tag", "class", "priority", "status", fieldParent, fieldDueWeek}
 }
@@ -0,0 +1,71 @@
+package board
+
+import (
+	"slices"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// Health check names, in the order they are reported.
+const (
+	CheckBlocked     = "blocked"
+	CheckOverdue     = "overdue"
+	CheckWIP         = "wip"
+	CheckStaleClaims = "stale-claims"
+)
+
+// HealthChecks returns the names of all health checks.
+func HealthChecks() []string {
+	return []string{CheckBlocked, CheckOverdue, CheckWIP, CheckStaleClaims}
+}
+
+// HealthCheck is the result of one health check. Count is the number of
+// offending tasks, or of columns over their WIP limit for the wip check.
+type HealthCheck struct {
+	Name    string `json:"name"`
+	Failing bool   `json:"failing"`
+	Count   int    `json:"count"`
+	Fatal   bool   `json:"fatal"`
+}
+
+// Health is a board health report. OK is false when any fatal check fails.
+type Health struct {
+	OK     bool          `json:"ok"`
+	Checks []HealthCheck `json:"checks"`
+}
+
+// CheckHealth evaluates every health check against tasks, marking those
+// named in fatal. Counts come from Summary; a claim is stale once it is older
+// than the board's claim timeout.
+func CheckHealth(cfg *config.Config, tasks []*task.Task, fatal []string, now time.Time) Health {
+	ov := Summary(cfg, tasks, SummaryOptions{Now: now})
+	counts := make(map[string]int, len(HealthChecks()))
+	for _, ss := range ov.Statuses {
+		counts[CheckBlocked] += ss.Blocked
+		counts[CheckOverdue] += ss.Overdue
+	}
+	counts[CheckWIP] = len(ov.OverWIP())
+	for _, t := range tasks {
+		if IsStaleClaim(t, cfg.ClaimTimeoutDuration(), now) {
+			counts[CheckStaleClaims]++
+		}
+	}
+
+	h := Health{OK: true}
+	for _, name := range HealthChecks() {
+		c := HealthCheck{Name: name, Count: counts[name], Failing: counts[name] > 0, Fatal: slices.Contains(fatal, name)}
+		if c.Failing && c.Fatal {
+			h.OK = false
+		}
+		h.Checks = append(h.Checks, c)
+	}
+	return h
+}
+
+// IsStaleClaim reports whether t is claimed and the claim has outlived
+// timeout. Claims never go stale when timeout is zero.
+func IsStaleClaim(t *task.Task, timeout time.Duration, now time.Time) bool {
+	return t.ClaimedBy != "" && t.ClaimedAt != nil && timeout > 0 && now.Sub(*t.ClaimedAt) > timeout
+}
@@ -0,0 +1,109 @@
+package board
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// HistoryEntry is one event in a task's timeline.
+type HistoryEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`
+	Detail    string    `json:"detail,omitempty"`
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Actor     string    `json:"actor,omitempty"`
+
+	Changes map[string]FieldChange `json:"changes,omitempty"`
+}
+
+// History is the chronological timeline of a single task.
+type History struct {
+	TaskID  int            `json:"task_id"`
+	Entries []HistoryEntry `json:"entries"`
+	// Incomplete is true when the task's create entry is missing and the
+	// activity log starts after the task was created, i.e. older entries
+	// were truncated away.
+	Incomplete bool `json:"incomplete,omitempty"`
+}
+
+// moveSeparator separates the old and new status in move log details.
+const moveSeparator = " -> "
+
+// TaskHistory merges a task's own lifecycle timestamps with the activity log
+// entries that reference it into a chronological timeline. Status moves come
+// from the transitions embedded in the task file, and from the log for moves
+// before the first embedded transition (trimmed, or recorded before embedded
+// history existed). log must be in file order (oldest first), as returned by
+// ReadLog.
+func TaskHistory(t *task.Task, log []LogEntry) History {
+	h := History{TaskID: t.ID}
+
+	created := HistoryEntry{Timestamp: t.Created, Action: "created", Detail: t.Title}
+	var embeddedSince *time.Time
+	if len(t.History) > 0 {
+		embeddedSince = &t.History[0].At
+	}
+	for _, tr := range t.History {
+		if tr.From == "" {
+			created.Actor = tr.Actor
+			continue
+		}
+		h.Entries = append(h.Entries, HistoryEntry{
+			Timestamp: tr.At, Action: "move", Detail: tr.From + moveSeparator + tr.To,
+			From: tr.From, To: tr.To, Actor: tr.Actor,
+		})
+	}
+	h.Entries = append(h.Entries, created)
+	if t.Started != nil {
+		h.Entries = append(h.Entries, HistoryEntry{Timestamp: *t.Started, Action: "started"})
+	}
+	if t.Completed != nil {
+		h.Entries = append(h.Entries, HistoryEntry{Timestamp: *t.Completed, Action: "completed"})
+	}
+
+	logged := false
+	for _, e := range log {
+		if e.TaskID != t.ID || (e.Action == "move" && embeddedSince != nil && !e.Timestamp.Before(*embeddedSince)) {
+			continue
+		}
+		if e.Action == "create" {
+			logged = true
+			continue
+		}
+		he := HistoryEntry{
+			Timestamp: e.Timestamp, Action: e.Action, Detail: e.Detail,
+			Reason: e.Reason, Actor: e.Actor, Changes: e.Changes,
+		}
+		if e.Action == "move" {
+			he.From, he.To = parseMoveDetail(e.Detail)
+		}
+		h.Entries = append(h.Entries, he)
+	}
+
+	sort.SliceStable(h.Entries, func(i, j int) bool {
+		return h.Entries[i].Timestamp.Before(h.Entries[j].Timestamp)
+	})
+
+	if !logged && len(log) > 0 && log[0].Timestamp.After(t.Created) {
+		h.Incomplete = true
+	}
+
+	return h
+}
+
+// parseMoveDetail splits a "old -> new" move detail into its two statuses,
+// ignoring any trailing reason. Returns empty strings if the detail is not in
+// that form.
+func parseMoveDetail(detail string) (string, string) {
+	detail, _, _ = strings.Cut(detail, reasonSeparator)
+	from, to, ok := strings.Cut(detail, moveSeparator)
+	if !ok {
+		return "", ""
+	}
+	return strings.TrimSpace(from), strings.TrimSpace(to)
+}
@@ -0,0 +1,68 @@
+package board
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestTaskHistoryMergesLoggedMovesBeforeEmbedded(t *testing.T) {
+	base := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return base.Add(time.Duration(h) * time.Hour) }
+
+	tk := &task.Task{
+		ID:      7,
+		Title:   "migrate",
+		Created: at(0),
+		History: []task.Transition{
+			{At: at(3), From: "todo", To: "in-progress"},
+			{At: at(5), From: "in-progress", To: "done"},
+		},
+	}
+	log := []LogEntry{
+		{Timestamp: at(0), Action: "create", TaskID: 7, Detail: "migrate"},
+		{Timestamp: at(1), Action: "move", TaskID: 7, Detail: "backlog -> todo"},
+		{Timestamp: at(3), Action: "move", TaskID: 7, Detail: "todo -> in-progress"},
+		{Timestamp: at(4), Action: "edit", TaskID: 7, Detail: "title"},
+		{Timestamp: at(5), Action: "move", TaskID: 7, Detail: "in-progress -> done"},
+		{Timestamp: at(2), Action: "move", TaskID: 8, Detail: "backlog -> todo"},
+	}
+
+	h := TaskHistory(tk, log)
+
+	var got []string
+	for _, e := range h.Entries {
+		got = append(got, e.Action+":"+e.Detail)
+	}
+	want := []string{
+		"created:migrate",
+		"move:backlog -> todo",
+		"move:todo -> in-progress",
+		"edit:title",
+		"move:in-progress -> done",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("entries = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("entry %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestTaskHistoryUsesLoggedMovesWithoutEmbedded(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	tk := &task.Task{ID: 1, Title: "old", Created: created}
+	log := []LogEntry{
+		{Timestamp: created, Action: "create", TaskID: 1},
+		{Timestamp: created.Add(time.Hour), Action: "move", TaskID: 1, Detail: "backlog -> todo"},
+	}
+
+	h := TaskHistory(tk, log)
+
+	if len(h.Entries) != 2 || h.Entries[1].From != "backlog" || h.Entries[1].To != "todo" {
+		t.Errorf("entries = %+v, want created then backlog -> todo", h.Entries)
+	}
+}
@@ -0,0 +1,51 @@
+package board
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/filelock"
+)
+
+// LockFileName is the advisory lock file in the board directory that
+// serializes board mutations.
+const LockFileName = ".lock"
+
+// LockTimeout bounds how long Lock waits for another process to release the
+// board lock.
+const LockTimeout = 10 * time.Second
+
+// Lock acquires the board lock in dir, waiting at most LockTimeout. On
+// timeout it returns an InternalError naming the process that appears to
+// hold the lock.
+func Lock(dir string) (unlock func() error, err error) {
+	unlock, err = filelock.LockWithTimeout(filepath.Join(dir, LockFileName), LockTimeout)
+	var timeout *filelock.TimeoutError
+	if errors.As(err, &timeout) {
+		details := map[string]any{"lock": timeout.Path, "timeout": timeout.Timeout.String()}
+		if h := timeout.Holder; h != nil {
+			details["holder_pid"] = h.PID
+			details["holder_since"] = h.Acquired
+			details["holder_running"] = h.Alive
+		}
+		return nil, clierr.New(clierr.InternalError, timeout.Error()).WithDetails(details)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("acquiring lock: %w", err)
+	}
+	return unlock, nil
+}
+
+// WithLock runs fn while holding the board lock in dir. Callers should read
+// the tasks they change inside fn so validation sees fresh data.
+func WithLock(dir string, fn func() error) error {
+	unlock, err := Lock(dir)
+	if err != nil {
+		return err
+	}
+	defer unlock() //nolint:errcheck // best-effort unlock on exit
+	return fn()
+}
@@ -6,27 +6,63 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
-
 const (
-	logFileName   = "activity.jsonl"
-	logFileMode   = 0o600
-	maxLogEntries = 10000 // truncate oldest entries when log exceeds this size
+	logFileName = "activity.jsonl"
+	logFileMode = 0o600
+
+	// The active log is rotated once it exceeds either threshold.
+	maxLogEntries = 10000
+	maxLogBytes   = 8 << 20
+
+	// Rotated logs are named activity-YYYYMMDD-HHMMSS-NNN.jsonl, where NNN
+	// numbers the logs rotated within the same second.
+	rotatedLogPrefix  = "activity-"
+	rotatedLogSuffix  = ".jsonl"
+	rotatedLogPattern = rotatedLogPrefix + "*" + rotatedLogSuffix
+	rotatedLogLayout  = "20060102-150405"
+
+	// reasonSeparator joins a detail and its rationale, e.g.
+	// "in-progress -> review | reason: needs QA".
+	reasonSeparator = " | reason: "
 )
 
-// LogEntry represents a single activity log entry.
+// logMaxFiles is the number of rotated log files kept; 0 keeps all.
+var logMaxFiles int
+
+// SetLogMaxFiles sets how many rotated activity log files are kept
+// (log.max_files); 0 keeps all of them.
+func SetLogMaxFiles(n int) {
+	logMaxFiles = n
+}
+
+// LogEntry represents a single activity log entry. Actor and Changes are
+// absent from entries written by older versions.
 type LogEntry struct {
-	Timestamp time.Time `json:"timestamp"`
-	Action    string    `json:"action"`
-	TaskID    int       `json:"task_id"`
-	Detail    string    `json:"detail"`
+	Timestamp time.Time              `json:"timestamp"`
+	Action    string                 `json:"action"`
+	TaskID    int                    `json:"task_id"`
+	Detail    string                 `json:"detail"`
+	Reason    string                 `json:"reason,omitempty"`
+	Actor     string                 `json:"actor,omitempty"`   // who made the change
+	Changes   map[string]FieldChange `json:"changes,omitempty"` // edited fields by name
+}
+
+// FieldChange is the old and new value of one edited task field.
+type FieldChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
 }
 
 // AppendLog appends a log entry to the activity log file.
-// If the log exceeds maxLogEntries, the oldest entries are truncated.
+// Once the log exceeds maxLogEntries or maxLogBytes it is rotated into a
+// dated file. Rotation happens after the entry is written, so a failed
+// rotation never loses it.
 func AppendLog(kanbanDir string, entry LogEntry) error {
 	path := filepath.Join(kanbanDir, logFileName)
 
@@ -45,56 +81,198 @@ func AppendLog(kanbanDir string, entry LogEntry) error {
 		return fmt.Errorf("writing log entry: %w", err)
 	}
 
-	// Truncate if needed (best-effort; errors are non-fatal).
-	_ = truncateLogIfNeeded(path)
+	// Rotate if needed (best-effort; errors are non-fatal).
+	_ = rotateLogIfNeeded(kanbanDir, path)
 
 	return nil
 }
 
-// truncateLogIfNeeded reads the log file and, if it exceeds maxLogEntries,
-// rewrites it keeping only the most recent entries.
-func truncateLogIfNeeded(path string) error {
-	f, err := os.Open(path) //nolint:gosec // trusted path
+// rotateLogIfNeeded renames the active log to a dated file when it exceeds
+// maxLogBytes or maxLogEntries, then prunes old rotated files beyond
+// logMaxFiles. The next append starts a fresh activity.jsonl.
+func rotateLogIfNeeded(kanbanDir, path string) error {
+	info, err := os.Stat(path)
 	if err != nil {
 		return err
 	}
+	if info.Size() <= maxLogBytes {
+		n, err := countLines(path)
+		if err != nil || n <= maxLogEntries {
+			return err
+		}
+	}
+
+	if err := os.Rename(path, rotatedLogPath(kanbanDir, time.Now())); err != nil {
+		return err
+	}
+	return pruneRotatedLogs(kanbanDir)
+}
+
+// countLines returns the number of lines in the file at path.
+func countLines(path string) (int, error) {
+	f, err := os.Open(path) //nolint:gosec // trusted path
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
 
-	var lines []string
+	n := 0
 	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxLogBytes)
 	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
+		n++
 	}
-	_ = f.Close()
+	return n, scanner.Err()
+}
 
-	if err := scanner.Err(); err != nil {
-		return err
+// rotatedLogPath returns an unused dated file name for a log rotated at ts.
+func rotatedLogPath(kanbanDir string, ts time.Time) string {
+	base := rotatedLogPrefix + ts.Format(rotatedLogLayout)
+	for i := 1; ; i++ {
+		path := filepath.Join(kanbanDir, fmt.Sprintf("%s-%03d%s", base, i, rotatedLogSuffix))
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return path
+		}
 	}
+}
 
-	if len(lines) <= maxLogEntries {
-		return nil
+// rotatedLogs returns the rotated log files, oldest first.
+func rotatedLogs(kanbanDir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(kanbanDir, rotatedLogPattern))
+	if err != nil {
+		return nil, err
 	}
+	sort.SliceStable(paths, func(i, j int) bool {
+		return rotatedLogKey(paths[i]) < rotatedLogKey(paths[j])
+	})
+	return paths, nil
+}
 
-	// Keep only the last maxLogEntries lines.
-	lines = lines[len(lines)-maxLogEntries:]
-
-	var buf strings.Builder
-	for _, line := range lines {
-		buf.WriteString(line)
-		buf.WriteByte('\n')
+// rotatedLogKey returns a sort key for a rotated log: its timestamp followed
+// by its zero-padded sequence number. Names from older versions carry no
+// sequence (the first log of a second) or an unpadded one, and sort among
+// the new names by the same key.
+func rotatedLogKey(path string) string {
+	name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), rotatedLogPrefix), rotatedLogSuffix)
+	if len(name) < len(rotatedLogLayout) {
+		return name
 	}
+	stamp, rest := name[:len(rotatedLogLayout)], name[len(rotatedLogLayout):]
+	seq := 0
+	if rest != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(rest, "-"))
+		if err != nil || !strings.HasPrefix(rest, "-") {
+			return name
+		}
+		seq = n
+	}
+	return fmt.Sprintf("%s-%09d", stamp, seq)
+}
 
-	return os.WriteFile(path, []byte(buf.String()), logFileMode)
+// pruneRotatedLogs removes the oldest rotated files beyond logMaxFiles.
+func pruneRotatedLogs(kanbanDir string) error {
+	if logMaxFiles <= 0 {
+		return nil
+	}
+	paths, err := rotatedLogs(kanbanDir)
+	if err != nil {
+		return err
+	}
+	for len(paths) > logMaxFiles {
+		if err := os.Remove(paths[0]); err != nil {
+			return err
+		}
+		paths = paths[1:]
+	}
+	return nil
 }
 
 // LogMutation appends an activity log entry. Errors are silently discarded
 // because logging should never fail a command.
 func LogMutation(kanbanDir, action string, taskID int, detail string) {
-	entry := LogEntry{
-		Timestamp: time.Now(),
-		Action:    action,
-		TaskID:    taskID,
-		Detail:    detail,
+	LogMutationWithReason(kanbanDir, action, taskID, detail, "")
+}
+
+// LogMutationWithReason is LogMutation with an optional rationale, stored in
+// the entry's Reason field.
+func LogMutationWithReason(kanbanDir, action string, taskID int, detail, reason string) {
+	LogMutationEntry(kanbanDir, LogEntry{
+		Action: action,
+		TaskID: taskID,
+		Detail: detail,
+		Reason: reason,
+	})
+}
+
+// LogMutationEntry appends entry stamped with the current time. An empty
+// Actor is filled from DefaultActor. Errors are silently discarded.
+func LogMutationEntry(kanbanDir string, entry LogEntry) {
+	entry.Timestamp = time.Now()
+	if entry.Actor == "" {
+		entry.Actor = DefaultActor()
 	}
 	_ = AppendLog(kanbanDir, entry)
 }
 
+// DefaultActor returns the agent name from $AGENTWATCH_AGENT, falling back
+// to $USER. Returns "" when neither is set.
+func DefaultActor() string {
+	if v := os.Getenv("AGENTWATCH_AGENT"); v != "" {
+		return v
+	}
+	return os.Getenv("USER")
+}
+
+// DetailWithReason appends a reason to a log detail in the form
+// "detail | reason: text". An empty reason returns detail unchanged.
+func DetailWithReason(detail, reason string) string {
+	if reason == "" {
+		return detail
+	}
+	return detail + reasonSeparator + reason
+}
+
+// ReadLog reads all entries from the activity log, including rotated
+// files, in chronological order (oldest first). A missing log yields no
+// entries. Malformed lines are skipped.
+func ReadLog(kanbanDir string) ([]LogEntry, error) {
+	paths, err := rotatedLogs(kanbanDir)
+	if err != nil {
+		return nil, fmt.Errorf("listing log files: %w", err)
+	}
+	paths = append(paths, filepath.Join(kanbanDir, logFileName))
+
+	var entries []LogEntry
+	for _, path := range paths {
+		if entries, err = readLogFile(path, entries); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// readLogFile appends the entries of one log file to entries. A missing file
+// adds nothing.
+func readLogFile(path string, entries []LogEntry) ([]LogEntry, error) {
+	f, err := os.Open(path) //nolint:gosec // log path from trusted kanban dir
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, fmt.Errorf("opening log file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e LogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading log file: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,60 @@
+package board
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRotatedLogsSortChronologically(t *testing.T) {
+	dir := t.TempDir()
+	// Older versions named the first log of a second without a sequence,
+	// then -1, -2 and so on.
+	legacy := []string{
+		"activity-20260301-090000.jsonl",
+		"activity-20260301-090000-1.jsonl",
+		"activity-20260301-090000-2.jsonl",
+		"activity-20260301-090000-10.jsonl",
+	}
+	for _, name := range legacy {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, logFileMode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := slices.Clone(legacy)
+	ts := time.Date(2026, 3, 1, 9, 0, 1, 0, time.UTC)
+	for i := 1; i <= 11; i++ {
+		if err := os.WriteFile(rotatedLogPath(dir, ts), nil, logFileMode); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, fmt.Sprintf("activity-20260301-090001-%03d.jsonl", i))
+	}
+
+	names := func() []string {
+		t.Helper()
+		paths, err := rotatedLogs(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, p := range paths {
+			names = append(names, filepath.Base(p))
+		}
+		return names
+	}
+	if got := names(); !slices.Equal(got, want) {
+		t.Errorf("rotated logs = %q, want %q", got, want)
+	}
+
+	SetLogMaxFiles(2)
+	t.Cleanup(func() { SetLogMaxFiles(0) })
+	if err := pruneRotatedLogs(dir); err != nil {
+		t.Fatal(err)
+	}
+	if got := names(); !slices.Equal(got, want[len(want)-2:]) {
+		t.Errorf("after pruning = %q, want the newest two %q", got, want[len(want)-2:])
+	}
+}
@@ -0,0 +1,79 @@
+package board
+
+import (
+	"slices"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// Placement is where Rerank puts a task within its column.
+type Placement int
+
+// Placements accepted by Rerank.
+const (
+	PlaceTop Placement = iota
+	PlaceBottom
+	PlaceBefore
+	PlaceAfter
+)
+
+// Rerank gives t a rank that places it relative to the other tasks in its
+// column. For PlaceBefore and PlaceAfter, anchor is the task to place t next
+// to; an unranked anchor is first ranked after every ranked task, which is
+// where it already sorts. Only t and that anchor change, so reordering never
+// rewrites the rest of the column. Returns the tasks whose rank changed.
+func Rerank(column []*task.Task, t *task.Task, where Placement, anchor *task.Task) []*task.Task {
+	var ranked []*task.Task
+	for _, c := range column {
+		if c.ID != t.ID && c.Rank != "" {
+			ranked = append(ranked, c)
+		}
+	}
+	slices.SortStableFunc(ranked, task.CompareRank)
+
+	changed := []*task.Task{t}
+	if (where == PlaceBefore || where == PlaceAfter) && anchor.Rank == "" {
+		anchor.Rank = task.RankBetween(lastRank(ranked), "")
+		ranked = append(ranked, anchor)
+		changed = append(changed, anchor)
+	}
+
+	switch where {
+	case PlaceTop:
+		first := ""
+		if len(ranked) > 0 {
+			first = ranked[0].Rank
+		}
+		t.Rank = task.RankBetween("", first)
+	case PlaceBottom:
+		t.Rank = task.RankBetween(lastRank(ranked), "")
+	case PlaceBefore:
+		i := indexByID(ranked, anchor.ID)
+		prev := ""
+		if i > 0 {
+			prev = ranked[i-1].Rank
+		}
+		t.Rank = task.RankBetween(prev, anchor.Rank)
+	case PlaceAfter:
+		i := indexByID(ranked, anchor.ID)
+		next := ""
+		if i >= 0 && i+1 < len(ranked) {
+			next = ranked[i+1].Rank
+		}
+		t.Rank = task.RankBetween(anchor.Rank, next)
+	}
+	return changed
+}
+
+// indexByID returns the position of task id in tasks, or -1.
+func indexByID(tasks []*task.Task, id int) int {
+	return slices.IndexFunc(tasks, func(t *task.Task) bool { return t.ID == id })
+}
+
+// lastRank returns the rank of the last task in ranked, or "".
+func lastRank(ranked []*task.Task) string {
+	if len(ranked) == 0 {
+		return ""
+	}
+	return ranked[len(ranked)-1].Rank
+}
@@ -0,0 +1,102 @@
+package board
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// Search score weights. A title hit outranks a tag hit, which outranks a body
+// hit, even when the lower-ranked fields are combined.
+const (
+	scoreTitle = 4
+	scoreTag   = 2
+	scoreBody  = 1
+)
+
+// SearchResult is a task matched by SearchRanked with its relevance score.
+type SearchResult struct {
+	*task.Task
+	Score   int      `json:"score"`
+	Matched []string `json:"matched"` // fields that matched: title, tags, body
+}
+
+// SearchRanked returns the tasks matching query (case-insensitive substring,
+// as in list --search), ordered by score and then by most recently updated.
+// Each term scores the fields it matched within its scope, so "title:auth"
+// scores only title hits.
+func SearchRanked(tasks []*task.Task, query string) []SearchResult {
+	terms := parseSearchQuery(query)
+	for i := range terms {
+		terms[i].value = strings.ToLower(terms[i].value)
+	}
+
+	var results []SearchResult
+	for _, t := range tasks {
+		if r, ok := scoreSearch(t, terms); ok {
+			results = append(results, r)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Updated.After(results[j].Updated)
+	})
+	return results
+}
+
+// scoreSearch scores t against lower-cased terms. Returns ok=false unless
+// every term matches at least one field in its scope.
+func scoreSearch(t *task.Task, terms []searchTerm) (SearchResult, bool) {
+	title := strings.ToLower(t.Title)
+	body := strings.ToLower(t.Body)
+	tags := make([]string, len(t.Tags))
+	for i, tag := range t.Tags {
+		tags[i] = strings.ToLower(tag)
+	}
+
+	r := SearchResult{Task: t}
+	var inTitle, inTags, inBody bool
+	for _, term := range terms {
+		hit := false
+		if (term.field == "" || term.field == "title") && strings.Contains(title, term.value) {
+			r.Score += scoreTitle
+			inTitle, hit = true, true
+		}
+		if (term.field == "" || term.field == "tag") && containsSubstring(tags, term.value) {
+			r.Score += scoreTag
+			inTags, hit = true, true
+		}
+		if (term.field == "" || term.field == "body") && strings.Contains(body, term.value) {
+			r.Score += scoreBody
+			inBody, hit = true, true
+		}
+		if !hit {
+			return SearchResult{}, false
+		}
+	}
+
+	if inTitle {
+		r.Matched = append(r.Matched, "title")
+	}
+	if inTags {
+		r.Matched = append(r.Matched, "tags")
+	}
+	if inBody {
+		r.Matched = append(r.Matched, "body")
+	}
+	return r, true
+}
+
+// containsSubstring reports whether any of ss contains sub.
+func containsSubstring(ss []string, sub string) bool {
+	for _, s := range ss {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package board
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func TestSearchRanked(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	tasks := []*task.Task{
+		{ID: 1, Title: "Fix auth token refresh", Body: "see the auth module", Updated: now},
+		{ID: 2, Title: "Write docs", Tags: []string{"auth"}, Updated: now},
+		{ID: 3, Title: "Cleanup", Body: "touches auth", Updated: now.Add(time.Hour)},
+		{ID: 4, Title: "Unrelated", Body: "nothing", Updated: now},
+	}
+
+	tests := []struct {
+		query   string
+		wantIDs []int
+		score   int
+		matched []string
+	}{
+		{"auth", []int{1, 2, 3}, scoreTitle + scoreBody, []string{"title", "body"}},
+		{"title:auth", []int{1}, scoreTitle, []string{"title"}},
+		{"tag:auth", []int{2}, scoreTag, []string{"tags"}},
+		{"body:auth", []int{3, 1}, scoreBody, []string{"body"}},
+		{"title:fix body:module", []int{1}, scoreTitle + scoreBody, []string{"title", "body"}},
+		{"title:docs body:auth", nil, 0, nil},
+		{"AUTH TOKEN", []int{1}, scoreTitle, []string{"title"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			results := SearchRanked(tasks, tt.query)
+			var ids []int
+			for _, r := range results {
+				ids = append(ids, r.ID)
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Fatalf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if len(results) == 0 {
+				return
+			}
+			if results[0].Score != tt.score || !slices.Equal(results[0].Matched, tt.matched) {
+				t.Errorf("first result score %d matched %v, want %d %v",
+					results[0].Score, results[0].Matched, tt.score, tt.matched)
+			}
+		})
+	}
+}
+
+func benchmarkTasks(n int) []*task.Task {
+	tasks := make([]*task.Task, n)
+	for i := range tasks {
+		tasks[i] = &task.Task{
+			ID:    i + 1,
+			Title: fmt.Sprintf("Task %d handles auth and billing", i),
+			Body:  strings.Repeat("Some longer body text describing the work. ", 20),
+			Tags:  []string{"backend", fmt.Sprintf("area-%d", i%10)},
+		}
+	}
+	return tasks
+}
+
+func BenchmarkSearchRanked(b *testing.B) {
+	tasks := benchmarkTasks(1000)
+	for _, q := range []string{"auth", "title:auth body:work", "nomatch"} {
+		b.Run(q, func(b *testing.B) {
+			for b.Loop() {
+				SearchRanked(tasks, q)
+			}
+		})
+	}
+}
+
+func BenchmarkMatchesSearch(b *testing.B) {
+	tasks := benchmarkTasks(1000)
+	for b.Loop() {
+		for _, t := range tasks {
+			matchesSearch(t, "title:auth body:work", false)
+		}
+	}
+}
@@ -1,22 +1,95 @@
 package board
 
 import (
+	"cmp"
+	"slices"
 	"sort"
 
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
 
-// Sort sorts tasks by the given field. For status and priority,
-// the config order is used (not alphabetical).
-func Sort(tasks []*task.Task, field string, reverse bool, cfg *config.Config) {
+// ValidSortFields returns the list of valid --sort field names.
+func ValidSortFields() []string {
+	return slices.Clone(config.SortFields)
+}
+
+// SortKey is one field of a composite sort.
+type SortKey = config.SortKey
+
+// ParseSortKeys parses a comma-separated sort spec such as
+// "priority,due,-created" (see config.ParseSortSpec), returning an
+// InvalidInput error for unknown fields.
+func ParseSortKeys(spec string) ([]SortKey, error) {
+	keys, err := config.ParseSortSpec(spec)
+	if err != nil {
+		return nil, clierr.New(clierr.InvalidInput, err.Error()).
+			WithDetails(map[string]any{"sort": spec, "valid": ValidSortFields()})
+	}
+	return keys, nil
+}
+
+// Sort sorts tasks by spec: a field, or a comma-separated list of fields
+// (see ParseSortKeys) compared in order until one differs. For status and
+// priority, the config order is used (not alphabetical). reverse applies to
+// the whole ordering, except that tasks of equal priority keep their rank
+// order (see task.CompareRank), then ID order. Returns an error, leaving
+// tasks unsorted, if spec is invalid.
+func Sort(tasks []*task.Task, spec string, reverse bool, cfg *config.Config) error {
+	keys, err := ParseSortKeys(spec)
+	if err != nil {
+		return err
+	}
+	if len(keys) > 1 || keys[0].Desc {
+		sortByKeys(tasks, keys, reverse, cfg)
+		return nil
+	}
+
+	field := keys[0].Field
 	sort.SliceStable(tasks, func(i, j int) bool {
+		if field == fieldPriority && samePriority(tasks[i], tasks[j], cfg) {
+			return rankLess(tasks[i], tasks[j])
+		}
 		less := compareTasks(tasks[i], tasks[j], field, cfg)
 		if reverse {
 			return !less
 		}
 		return less
 	})
+	return nil
+}
+
+// SortByID sorts tasks by ascending ID.
+func SortByID(tasks []*task.Task) {
+	slices.SortStableFunc(tasks, func(a, b *task.Task) int { return cmp.Compare(a.ID, b.ID) })
+}
+
+func sortByKeys(tasks []*task.Task, keys []SortKey, reverse bool, cfg *config.Config) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if reverse {
+			a, b = b, a
+		}
+		for _, k := range keys {
+			x, y := a, b
+			if k.Desc {
+				x, y = b, a
+			}
+			if compareTasks(x, y, k.Field, cfg) {
+				return true
+			}
+			if compareTasks(y, x, k.Field, cfg) {
+				return false
+			}
+			if k.Field == fieldPriority {
+				if c := task.CompareRank(tasks[i], tasks[j]); c != 0 {
+					return c < 0
+				}
+			}
+		}
+		return false
+	})
 }
 
 func compareTasks(a, b *task.Task, field string, cfg *config.Config) bool {
@@ -38,6 +111,19 @@ func compareTasks(a, b *task.Task, field string, cfg *config.Config) bool {
 	}
 }
 
+// samePriority reports whether a and b have the same priority.
+func samePriority(a, b *task.Task, cfg *config.Config) bool {
+	return cfg.PriorityIndex(a.Priority) == cfg.PriorityIndex(b.Priority)
+}
+
+// rankLess orders tasks of equal priority by rank, then ID.
+func rankLess(a, b *task.Task) bool {
+	if c := task.CompareRank(a, b); c != 0 {
+		return c < 0
+	}
+	return a.ID < b.ID
+}
+
 func compareDue(a, b *task.Task) bool {
 	if a.Due == nil && b.Due == nil {
 		return false
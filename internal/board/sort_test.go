@@ -0,0 +1,76 @@
+package board
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+func dueOn(day int) *date.Date {
+	return &date.Date{Time: time.Date(2026, 6, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestSortNilDueTieBreak(t *testing.T) {
+	cfg := config.NewDefault("test")
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newTasks := func() []*task.Task {
+		return []*task.Task{
+			{ID: 1, Priority: "low", Created: base.Add(3 * time.Hour)},
+			{ID: 2, Priority: "high", Due: dueOn(10), Created: base},
+			{ID: 3, Priority: "high", Created: base.Add(time.Hour)},
+			{ID: 4, Priority: "low", Due: dueOn(5), Created: base.Add(2 * time.Hour)},
+			{ID: 5, Priority: "high", Due: dueOn(5), Created: base.Add(4 * time.Hour)},
+		}
+	}
+
+	tests := []struct {
+		spec    string
+		reverse bool
+		want    []int
+	}{
+		{"due", false, []int{4, 5, 2, 1, 3}},
+		{"due,id", false, []int{4, 5, 2, 1, 3}},
+		{"due,-id", false, []int{5, 4, 2, 3, 1}},
+		{"due,priority", false, []int{4, 5, 2, 1, 3}},
+		{"due,-created", false, []int{5, 4, 2, 1, 3}},
+		{"-due,id", false, []int{1, 3, 2, 4, 5}},
+		{"due,id", true, []int{3, 1, 2, 5, 4}},
+		{"priority,due", false, []int{4, 1, 5, 2, 3}},
+	}
+	for _, tt := range tests {
+		name := tt.spec
+		if tt.reverse {
+			name += " reversed"
+		}
+		t.Run(name, func(t *testing.T) {
+			tasks := newTasks()
+			if err := Sort(tasks, tt.spec, tt.reverse, cfg); err != nil {
+				t.Fatal(err)
+			}
+			ids := make([]int, len(tasks))
+			for i, tk := range tasks {
+				ids[i] = tk.ID
+			}
+			if !slices.Equal(ids, tt.want) {
+				t.Errorf("Sort(%q, reverse=%v) = %v, want %v", tt.spec, tt.reverse, ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortRejectsInvalidSpec(t *testing.T) {
+	cfg := config.NewDefault("test")
+	for _, spec := range []string{"bogus", "priority,bogus", "-", "due,,id"} {
+		tasks := []*task.Task{{ID: 2}, {ID: 1}}
+		if err := Sort(tasks, spec, false, cfg); err == nil {
+			t.Errorf("Sort(%q) = nil, want error", spec)
+		}
+		if tasks[0].ID != 2 {
+			t.Errorf("Sort(%q) reordered tasks despite the error", spec)
+		}
+	}
+}
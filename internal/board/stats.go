@@ -0,0 +1,197 @@
+package board
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+const percentile85 = 85
+
+// DurationStats summarizes a set of durations.
+type DurationStats struct {
+	Count  int
+	Mean   time.Duration
+	Median time.Duration
+	P85    time.Duration
+}
+
+// MarshalJSON renders the durations as whole seconds.
+func (d DurationStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Count         int   `json:"count"`
+		MeanSeconds   int64 `json:"mean_seconds"`
+		MedianSeconds int64 `json:"median_seconds"`
+		P85Seconds    int64 `json:"p85_seconds"`
+	}{
+		Count:         d.Count,
+		MeanSeconds:   int64(d.Mean.Seconds()),
+		MedianSeconds: int64(d.Median.Seconds()),
+		P85Seconds:    int64(d.P85.Seconds()),
+	})
+}
+
+// WeekCount is the number of tasks completed in one ISO week.
+type WeekCount struct {
+	Week  string    `json:"week"` // ISO week, e.g. "2026-W07"
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+// FlowStats holds flow metrics for a set of tasks.
+type FlowStats struct {
+	Since          *time.Time    `json:"since,omitempty"`
+	Completed      int           `json:"completed"`
+	Throughput     []WeekCount   `json:"throughput"`
+	LeadTime       DurationStats `json:"lead_time"`
+	CycleTime      DurationStats `json:"cycle_time"`
+	MissingStarted int           `json:"missing_started"` // completed tasks excluded from cycle time
+	Estimates      EstimateStats `json:"estimates"`
+	Logged         EstimateStats `json:"logged"` // estimate vs worklog time
+	WIP            []StatusCount `json:"wip"`
+}
+
+// EstimateStats compares estimates with actual cycle time for completed tasks
+// that have both.
+type EstimateStats struct {
+	Count     int     `json:"count"`
+	MeanRatio float64 `json:"mean_ratio,omitempty"` // actual / estimate; 1.0 is a perfect estimate
+	Over      int     `json:"over"`                 // tasks that took longer than estimated
+	Under     int     `json:"under"`                // tasks that finished within the estimate
+}
+
+// StatusCount holds a count for a status.
+type StatusCount struct {
+	Status string `json:"status"`
+	Count  int    `json:"count"`
+}
+
+// Stats computes flow metrics. Completed tasks are those with a Completed
+// timestamp (including archived ones); when since is non-nil, only tasks
+// completed at or after since are considered for throughput, lead and cycle
+// time. WIP counts the current tasks per active (non-terminal) status.
+func Stats(cfg *config.Config, tasks []*task.Task, since *time.Time, now time.Time) FlowStats {
+	s := FlowStats{Since: since}
+
+	var lead, cycle []time.Duration
+	weeks := make(map[string]*WeekCount)
+	for _, t := range tasks {
+		if t.Completed == nil || (since != nil && t.Completed.Before(*since)) {
+			continue
+		}
+		s.Completed++
+		lead = append(lead, t.Completed.Sub(t.Created))
+		if t.Started != nil {
+			cycle = append(cycle, t.Completed.Sub(*t.Started))
+		} else {
+			s.MissingStarted++
+		}
+		if est, actual, ok := task.EstimateVariance(t); ok {
+			addEstimate(&s.Estimates, est, actual)
+		}
+		if est, logged, ok := task.LoggedVariance(t); ok {
+			addEstimate(&s.Logged, est, logged)
+		}
+		wc := weekBucket(weeks, *t.Completed)
+		wc.Count++
+	}
+
+	if since != nil {
+		// Include empty weeks so throughput gaps are visible.
+		for w := *since; !w.After(now); w = w.AddDate(0, 0, 7) {
+			weekBucket(weeks, w)
+		}
+	}
+
+	s.Throughput = make([]WeekCount, 0, len(weeks))
+	for _, wc := range weeks {
+		s.Throughput = append(s.Throughput, *wc)
+	}
+	sort.Slice(s.Throughput, func(i, j int) bool {
+		return s.Throughput[i].Start.Before(s.Throughput[j].Start)
+	})
+
+	if s.Estimates.Count > 0 {
+		s.Estimates.MeanRatio /= float64(s.Estimates.Count)
+	}
+	if s.Logged.Count > 0 {
+		s.Logged.MeanRatio /= float64(s.Logged.Count)
+	}
+	s.LeadTime = summarizeDurations(lead)
+	s.CycleTime = summarizeDurations(cycle)
+
+	counts := CountByStatus(tasks)
+	active := cfg.ActiveStatuses()
+	s.WIP = make([]StatusCount, 0, len(active))
+	for _, st := range active {
+		s.WIP = append(s.WIP, StatusCount{Status: st, Count: counts[st]})
+	}
+
+	return s
+}
+
+// addEstimate accumulates one estimate/actual pair. MeanRatio holds the running
+// sum until Stats divides it by Count.
+func addEstimate(es *EstimateStats, est, actual time.Duration) {
+	es.Count++
+	es.MeanRatio += float64(actual) / float64(est)
+	if actual > est {
+		es.Over++
+	} else {
+		es.Under++
+	}
+}
+
+// weekBucket returns the bucket for the ISO week containing ts, creating it.
+func weekBucket(weeks map[string]*WeekCount, ts time.Time) *WeekCount {
+	key := isoWeekKey(ts)
+	if wc, ok := weeks[key]; ok {
+		return wc
+	}
+	// Monday of the ISO week.
+	offset := (int(ts.Weekday()) + 6) % 7 //nolint:mnd // shift Sunday=0 to Monday=0
+	y, m, d := ts.AddDate(0, 0, -offset).Date()
+	wc := &WeekCount{Week: key, Start: time.Date(y, m, d, 0, 0, 0, 0, ts.Location())}
+	weeks[key] = wc
+	return wc
+}
+
+// isoWeekKey formats the ISO week containing ts, e.g. "2026-W07". Keys sort
+// chronologically as strings.
+func isoWeekKey(ts time.Time) string {
+	year, week := ts.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// summarizeDurations computes mean, median and 85th percentile (nearest rank).
+func summarizeDurations(ds []time.Duration) DurationStats {
+	if len(ds) == 0 {
+		return DurationStats{}
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	return DurationStats{
+		Count:  len(sorted),
+		Mean:   sum / time.Duration(len(sorted)),
+		Median: percentile(sorted, 50), //nolint:mnd // median
+		P85:    percentile(sorted, percentile85),
+	}
+}
+
+// percentile returns the p-th percentile of sorted using the nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 //nolint:mnd // ceil(p/100 * n)
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,137 @@
+package board
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// TreeNode is one task in the parent/child hierarchy.
+type TreeNode struct {
+	ID            int         `json:"id"`
+	Title         string      `json:"title"`
+	Status        string      `json:"status"`
+	Parent        *int        `json:"parent,omitempty"`
+	ChildrenDone  int         `json:"children_done"`
+	ChildrenTotal int         `json:"children_total"`
+	Orphan        string      `json:"orphan,omitempty"` // why the parent is not shown
+	Children      []*TreeNode `json:"children,omitempty"`
+}
+
+// TreeOptions controls which tasks Tree includes.
+type TreeOptions struct {
+	RootID   int      // when non-zero, only this task's subtree
+	Statuses []string // when set, keep tasks in these statuses and their ancestors
+}
+
+// Tree builds the parent→children forest of non-archived tasks. Tasks whose
+// parent is archived or missing are placed at the top level with Orphan set.
+// Child progress counts every direct child, including archived ones, and
+// treats terminal statuses as done. Returns nil if RootID is not found.
+func Tree(cfg *config.Config, tasks []*task.Task, opts TreeOptions) []*TreeNode {
+	byID := make(map[int]*task.Task, len(tasks))
+	children := make(map[int][]*task.Task)
+	for _, t := range tasks {
+		byID[t.ID] = t
+		if t.Parent != nil {
+			children[*t.Parent] = append(children[*t.Parent], t)
+		}
+	}
+	for _, kids := range children {
+		sort.Slice(kids, func(i, j int) bool { return kids[i].ID < kids[j].ID })
+	}
+
+	visible := func(t *task.Task) bool { return t.Status != config.ArchivedStatus }
+
+	// Top-level tasks: no parent, or a parent that is not shown.
+	var roots []*task.Task
+	if opts.RootID != 0 {
+		t, ok := byID[opts.RootID]
+		if !ok {
+			return nil
+		}
+		roots = []*task.Task{t}
+	} else {
+		for _, t := range tasks {
+			if !visible(t) {
+				continue
+			}
+			if t.Parent == nil {
+				roots = append(roots, t)
+			} else if p, ok := byID[*t.Parent]; !ok || !visible(p) {
+				roots = append(roots, t)
+			}
+		}
+		sort.Slice(roots, func(i, j int) bool { return roots[i].ID < roots[j].ID })
+	}
+
+	visited := make(map[int]bool)
+	var build func(t *task.Task) *TreeNode
+	build = func(t *task.Task) *TreeNode {
+		visited[t.ID] = true
+		n := &TreeNode{ID: t.ID, Title: t.Title, Status: t.Status, Parent: t.Parent}
+		for _, c := range children[t.ID] {
+			n.ChildrenTotal++
+			if cfg.IsTerminalStatus(c.Status) {
+				n.ChildrenDone++
+			}
+			if visible(c) && !visited[c.ID] {
+				n.Children = append(n.Children, build(c))
+			}
+		}
+		return n
+	}
+
+	var forest []*TreeNode
+	for _, t := range roots {
+		n := build(t)
+		if opts.RootID == 0 {
+			n.Orphan = orphanReason(t, byID)
+		}
+		forest = append(forest, n)
+	}
+
+	// Tasks caught in a parent cycle are unreachable from any root; show them
+	// at the top level so nothing silently disappears.
+	if opts.RootID == 0 {
+		for _, t := range tasks {
+			if visible(t) && !visited[t.ID] {
+				n := build(t)
+				n.Orphan = "parent cycle"
+				forest = append(forest, n)
+			}
+		}
+	}
+
+	if len(opts.Statuses) > 0 {
+		forest = pruneTree(forest, opts.Statuses)
+	}
+	return forest
+}
+
+// orphanReason explains why a top-level task's parent is not shown, or returns
+// "" for tasks without a parent.
+func orphanReason(t *task.Task, byID map[int]*task.Task) string {
+	if t.Parent == nil {
+		return ""
+	}
+	if _, ok := byID[*t.Parent]; !ok {
+		return fmt.Sprintf("parent #%d not found", *t.Parent)
+	}
+	return fmt.Sprintf("parent #%d archived", *t.Parent)
+}
+
+// pruneTree keeps nodes whose status is in statuses, plus their ancestors.
+func pruneTree(nodes []*TreeNode, statuses []string) []*TreeNode {
+	var kept []*TreeNode
+	for _, n := range nodes {
+		n.Children = pruneTree(n.Children, statuses)
+		if len(n.Children) > 0 || slices.Contains(statuses, n.Status) {
+			kept = append(kept, n)
+		}
+	}
+	return kept
+}
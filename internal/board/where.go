@@ -0,0 +1,78 @@
+package board
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+)
+
+// WhereKeys lists the keys accepted by ParseWhere.
+func WhereKeys() []string {
+	return []string{"status", "priority", "assignee", "tag", "class", "claimed-by", "blocked", "parent", "search"}
+}
+
+// ParseWhere parses a where-clause such as "status=todo,tag=frontend" into
+// filter options. Clauses are ANDed; status, priority and tag accept several
+// values separated by "|" (e.g. "status=todo|review"), and repeated tag
+// clauses require every tag. Archived tasks are excluded unless a status is
+// given, matching list.
+func ParseWhere(expr string) (FilterOptions, error) {
+	var opts FilterOptions
+	if strings.TrimSpace(expr) == "" {
+		return opts, clierr.New(clierr.InvalidInput, "where clause is empty")
+	}
+
+	for _, clause := range strings.Split(expr, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(clause), "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return opts, clierr.Newf(clierr.InvalidInput, "invalid where clause %q (expected key=value)", clause)
+		}
+		if err := applyWhereClause(&opts, key, value); err != nil {
+			return opts, err
+		}
+	}
+
+	ApplyArchived(&opts, ArchivedDefault)
+	return opts, nil
+}
+
+func applyWhereClause(opts *FilterOptions, key, value string) error {
+	switch key {
+	case "status":
+		opts.Statuses = strings.Split(value, "|")
+	case "priority":
+		opts.Priorities = strings.Split(value, "|")
+	case "assignee":
+		opts.Assignee = value
+	case "tag":
+		if tags := strings.Split(value, "|"); len(tags) > 1 {
+			opts.AnyTags = tags
+		} else {
+			opts.Tags = append(opts.Tags, value)
+		}
+	case "class":
+		opts.Class = value
+	case "claimed-by":
+		opts.ClaimedBy = value
+	case "search":
+		opts.Search = value
+	case "blocked":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return clierr.Newf(clierr.InvalidInput, "invalid where value blocked=%q (expected true or false)", value)
+		}
+		opts.Blocked = &b
+	case "parent":
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return clierr.Newf(clierr.InvalidInput, "invalid where value parent=%q (expected a task ID)", value)
+		}
+		opts.ParentID = &id
+	default:
+		return clierr.Newf(clierr.InvalidInput, "unknown where key %q; valid: %s",
+			key, strings.Join(WhereKeys(), ", "))
+	}
+	return nil
+}
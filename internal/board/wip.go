@@ -0,0 +1,86 @@
+package board
+
+import (
+	"fmt"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// EnforceWIP checks every WIP limit that applies when t moves from
+// currentStatus (empty for a new task) to targetStatus: the priority's
+// in-flight limit, the class's board-wide limit, and the column limit unless
+// the class bypasses it. Must be called under the board lock.
+func EnforceWIP(cfg *config.Config, t *task.Task, currentStatus, targetStatus string) error {
+	if err := EnforcePriorityWIP(cfg, t, targetStatus); err != nil {
+		return err
+	}
+	if t.Class != "" && len(cfg.Classes) > 0 {
+		return enforceClassWIP(cfg, t, currentStatus, targetStatus)
+	}
+	return EnforceColumnWIP(cfg, currentStatus, targetStatus)
+}
+
+// EnforceColumnWIP checks that targetStatus has room for one more task.
+func EnforceColumnWIP(cfg *config.Config, currentStatus, targetStatus string) error {
+	if cfg.WIPLimit(targetStatus) == 0 {
+		return nil
+	}
+	allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return fmt.Errorf("reading tasks for WIP check: %w", err)
+	}
+	return CheckWIPLimit(cfg, CountByStatus(allTasks), targetStatus, currentStatus)
+}
+
+// enforceClassWIP checks WIP limits considering class of service. Classes
+// with bypass_column_wip (e.g. expedite) skip the column limit but still have
+// their own board-wide limit.
+func enforceClassWIP(cfg *config.Config, t *task.Task, currentStatus, targetStatus string) error {
+	classConf := cfg.ClassByName(t.Class)
+
+	if classConf != nil && classConf.WIPLimit > 0 {
+		allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+		if err != nil {
+			return fmt.Errorf("reading tasks for class WIP check: %w", err)
+		}
+		count := 0
+		for _, other := range allTasks {
+			if other.Class == t.Class && other.ID != t.ID {
+				count++
+			}
+		}
+		if count >= classConf.WIPLimit {
+			return task.ValidateClassWIPExceeded(t.Class, classConf.WIPLimit, count)
+		}
+	}
+
+	if classConf != nil && classConf.BypassColumnWIP {
+		return nil
+	}
+	return EnforceColumnWIP(cfg, currentStatus, targetStatus)
+}
+
+// EnforcePriorityWIP checks the board-wide in-flight limit for t's priority
+// when targetStatus is in flight. Class bypass_column_wip only skips column
+// limits, so expedite tasks are still subject to it.
+func EnforcePriorityWIP(cfg *config.Config, t *task.Task, targetStatus string) error {
+	limit := cfg.PriorityWIPLimit(t.Priority)
+	if limit == 0 || !cfg.IsInFlightStatus(targetStatus) {
+		return nil
+	}
+	allTasks, _, err := task.ReadAllLenient(cfg.TasksPath())
+	if err != nil {
+		return fmt.Errorf("reading tasks for priority WIP check: %w", err)
+	}
+	count := 0
+	for _, other := range allTasks {
+		if other.ID != t.ID && other.Priority == t.Priority && cfg.IsInFlightStatus(other.Status) {
+			count++
+		}
+	}
+	if count >= limit {
+		return task.ValidatePriorityWIPExceeded(t.Priority, limit, count)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package board
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// newWIPBoard writes tasks to a fresh board with the given limits.
+func newWIPBoard(t *testing.T, setup func(*config.Config), tasks ...*task.Task) *config.Config {
+	t.Helper()
+	cfg, err := config.Init(t.TempDir(), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	setup(cfg)
+	for _, tk := range tasks {
+		if err := task.Write(filepath.Join(cfg.TasksPath(), task.FilenameFor(cfg, tk)), tk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cfg
+}
+
+func TestEnforceWIP(t *testing.T) {
+	existing := []*task.Task{
+		{ID: 1, Title: "one", Status: "in-progress", Priority: "high", Class: "standard"},
+		{ID: 2, Title: "two", Status: "todo", Priority: "medium", Class: "expedite"},
+	}
+	cfg := newWIPBoard(t, func(c *config.Config) {
+		c.WIPLimits = map[string]int{"in-progress": 1}
+		c.PriorityWIPLimits = map[string]int{"high": 1}
+	}, existing...)
+
+	tests := []struct {
+		name     string
+		task     *task.Task
+		from, to string
+		wantErr  bool
+	}{
+		{"column full", &task.Task{ID: 3, Priority: "low", Class: "standard"}, "todo", "in-progress", true},
+		{"already in column", &task.Task{ID: 1, Priority: "low", Class: "standard"}, "in-progress", "in-progress", false},
+		{"new task into full column", &task.Task{ID: 3, Priority: "low", Class: "standard"}, "", "in-progress", true},
+		{"column with room", &task.Task{ID: 3, Priority: "low", Class: "standard"}, "backlog", "todo", false},
+		{"class limit reached", &task.Task{ID: 3, Priority: "low", Class: "expedite"}, "backlog", "todo", true},
+		{"priority limit reached", &task.Task{ID: 3, Priority: "high", Class: "standard"}, "backlog", "review", true},
+		{"priority limit outside flight", &task.Task{ID: 3, Priority: "high", Class: "standard"}, "backlog", "done", false},
+		{"no class uses column limit", &task.Task{ID: 3, Priority: "low"}, "todo", "in-progress", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := EnforceWIP(cfg, tt.task, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnforceWIP(%s -> %s) = %v, want error %v", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnforceWIPClassBypass(t *testing.T) {
+	existing := []*task.Task{
+		{ID: 1, Title: "one", Status: "in-progress", Priority: "high", Class: "standard"},
+	}
+	cfg := newWIPBoard(t, func(c *config.Config) {
+		c.WIPLimits = map[string]int{"in-progress": 1}
+	}, existing...)
+	expedite := &task.Task{ID: 2, Priority: "high", Class: "expedite"}
+
+	if err := EnforceWIP(cfg, expedite, "todo", "in-progress"); err != nil {
+		t.Errorf("expedite into full column: %v, want bypass", err)
+	}
+
+	cfg.PriorityWIPLimits = map[string]int{"high": 1}
+	if err := EnforceWIP(cfg, expedite, "todo", "in-progress"); err == nil {
+		t.Error("expedite over the priority limit: got nil, want error (bypass is column-only)")
+	}
+	if err := EnforcePriorityWIP(cfg, expedite, "in-progress"); err == nil {
+		t.Error("EnforcePriorityWIP for a priority shift: got nil, want error")
+	}
+}
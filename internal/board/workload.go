@@ -0,0 +1,77 @@
+package board
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// AssigneeWorkload is the open work committed to one assignee.
+type AssigneeWorkload struct {
+	Assignee    string
+	Tasks       int
+	Unestimated int           // tasks without a parseable estimate
+	Total       time.Duration // sum of parsed estimates
+}
+
+// MarshalJSON renders the total as whole seconds.
+func (a AssigneeWorkload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Assignee     string `json:"assignee,omitempty"`
+		Tasks        int    `json:"tasks"`
+		Unestimated  int    `json:"unestimated"`
+		TotalSeconds int64  `json:"total_seconds"`
+	}{a.Assignee, a.Tasks, a.Unestimated, int64(a.Total.Seconds())})
+}
+
+// Workload summarizes open work per assignee.
+type Workload struct {
+	Assignees  []AssigneeWorkload `json:"assignees"`
+	Unassigned AssigneeWorkload   `json:"unassigned"`
+}
+
+// ComputeWorkload groups non-terminal tasks by assignee and sums their
+// estimates. Assignees are sorted by total estimate descending, then by task
+// count and name; tasks without an assignee are reported separately.
+func ComputeWorkload(cfg *config.Config, tasks []*task.Task) Workload {
+	byAssignee := make(map[string]*AssigneeWorkload)
+	var w Workload
+	for _, t := range tasks {
+		if cfg.IsTerminalStatus(t.Status) {
+			continue
+		}
+		bucket := &w.Unassigned
+		if t.Assignee != "" {
+			bucket = byAssignee[t.Assignee]
+			if bucket == nil {
+				bucket = &AssigneeWorkload{Assignee: t.Assignee}
+				byAssignee[t.Assignee] = bucket
+			}
+		}
+		bucket.Tasks++
+		if d, err := task.ParseEstimate(t.Estimate); t.Estimate != "" && err == nil {
+			bucket.Total += d
+		} else {
+			bucket.Unestimated++
+		}
+	}
+
+	w.Assignees = make([]AssigneeWorkload, 0, len(byAssignee))
+	for _, a := range byAssignee {
+		w.Assignees = append(w.Assignees, *a)
+	}
+	sort.Slice(w.Assignees, func(i, j int) bool {
+		a, b := w.Assignees[i], w.Assignees[j]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		if a.Tasks != b.Tasks {
+			return a.Tasks > b.Tasks
+		}
+		return a.Assignee < b.Assignee
+	})
+	return w
+}
@@ -10,28 +10,31 @@ import (
 
 // Error code constants — uppercase, underscore-separated, stable across minor versions.
 const (
-	TaskNotFound       = "TASK_NOT_FOUND"
-	BoardNotFound      = "BOARD_NOT_FOUND"
-	BoardAlreadyExists = "BOARD_ALREADY_EXISTS"
-	InvalidInput       = "INVALID_INPUT"
-	InvalidStatus      = "INVALID_STATUS"
-	InvalidPriority    = "INVALID_PRIORITY"
-	InvalidDate        = "INVALID_DATE"
-	InvalidTaskID      = "INVALID_TASK_ID"
-	WIPLimitExceeded   = "WIP_LIMIT_EXCEEDED"
-	DependencyNotFound = "DEPENDENCY_NOT_FOUND"
-	SelfReference      = "SELF_REFERENCE"
-	NoChanges          = "NO_CHANGES"
-	BoundaryError      = "BOUNDARY_ERROR"
-	StatusConflict     = "STATUS_CONFLICT"
-	ConfirmationReq    = "CONFIRMATION_REQUIRED"
-	TaskClaimed        = "TASK_CLAIMED"
-	InvalidClass       = "INVALID_CLASS"
-	ClassWIPExceeded   = "CLASS_WIP_EXCEEDED"
-	ClaimRequired      = "CLAIM_REQUIRED"
-	NothingToPick      = "NOTHING_TO_PICK"
-	InvalidGroupBy     = "INVALID_GROUP_BY"
-	InternalError      = "INTERNAL_ERROR"
+	TaskNotFound        = "TASK_NOT_FOUND"
+	TaskIDExists        = "TASK_ID_EXISTS"
+	BoardNotFound       = "BOARD_NOT_FOUND"
+	BoardAlreadyExists  = "BOARD_ALREADY_EXISTS"
+	InvalidInput        = "INVALID_INPUT"
+	InvalidStatus       = "INVALID_STATUS"
+	InvalidPriority     = "INVALID_PRIORITY"
+	InvalidDate         = "INVALID_DATE"
+	InvalidTaskID       = "INVALID_TASK_ID"
+	WIPLimitExceeded    = "WIP_LIMIT_EXCEEDED"
+	DependencyNotFound  = "DEPENDENCY_NOT_FOUND"
+	DependencyCycle     = "DEPENDENCY_CYCLE"
+	SelfReference       = "SELF_REFERENCE"
+	NoChanges           = "NO_CHANGES"
+	BoundaryError       = "BOUNDARY_ERROR"
+	StatusConflict      = "STATUS_CONFLICT"
+	ConfirmationReq     = "CONFIRMATION_REQUIRED"
+	TaskClaimed         = "TASK_CLAIMED"
+	InvalidClass        = "INVALID_CLASS"
+	ClassWIPExceeded    = "CLASS_WIP_EXCEEDED"
+	PriorityWIPExceeded = "PRIORITY_WIP_EXCEEDED"
+	ClaimRequired       = "CLAIM_REQUIRED"
+	NothingToPick       = "NOTHING_TO_PICK"
+	InvalidGroupBy      = "INVALID_GROUP_BY"
+	InternalError       = "INTERNAL_ERROR"
 )
 
 // Error represents a structured CLI error with a machine-readable code.
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"go.yaml.in/yaml/v3"
 
+	"github.com/twiced-technology-gmbh/agentwatch/internal/atomicfile"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
 )
 
 const fileMode = 0o600
@@ -22,20 +25,39 @@ var (
 
 // Config represents the kanban board configuration.
 type Config struct {
-	Version      int            `yaml:"version"`
-	Board        BoardConfig    `yaml:"board"`
-	TasksDir     string         `yaml:"tasks_dir"`
-	Statuses     []StatusConfig `yaml:"statuses"`
-	Priorities   []string       `yaml:"priorities"`
-	Defaults     DefaultsConfig `yaml:"defaults"`
-	WIPLimits    map[string]int `yaml:"wip_limits,omitempty"`
+	Version    int              `yaml:"version"`
+	Board      BoardConfig      `yaml:"board"`
+	TasksDir   string           `yaml:"tasks_dir"`
+	Tasks      TasksConfig      `yaml:"tasks,omitempty"`
+	Statuses   []StatusConfig   `yaml:"statuses"`
+	Priorities []PriorityConfig `yaml:"priorities"`
+	Defaults   DefaultsConfig   `yaml:"defaults"`
+	WIPLimits  map[string]int   `yaml:"wip_limits,omitempty"`
+	// PriorityWIPLimits caps in-flight tasks per priority board-wide.
+	PriorityWIPLimits map[string]int `yaml:"priority_wip_limits,omitempty"`
+	// WIPMinimums flags statuses that need replenishing below a task count.
+	WIPMinimums  map[string]int `yaml:"wip_minimums,omitempty"`
 	ClaimTimeout string         `yaml:"claim_timeout,omitempty"`
-	Classes      []ClassConfig  `yaml:"classes,omitempty"`
-	TUI          TUIConfig      `yaml:"tui,omitempty"`
-	NextID       int            `yaml:"next_id"`
+	// AutoArchiveAfter is how long a completed task stays in its terminal
+	// status before sweep archives it; empty disables auto-archiving.
+	AutoArchiveAfter string        `yaml:"auto_archive_after,omitempty"`
+	Classes          []ClassConfig `yaml:"classes,omitempty"`
+	TUI              TUIConfig     `yaml:"tui,omitempty"`
+	Limits           LimitsConfig  `yaml:"limits,omitempty"`
+	Log              LogConfig     `yaml:"log,omitempty"`
+	NextID           int           `yaml:"next_id"`
+
+	// Unknown holds top-level keys this version does not recognize (e.g.
+	// written by a newer agentwatch), so Save re-emits them instead of
+	// dropping them.
+	Unknown map[string]any `yaml:",inline"`
 
 	// dir is the absolute path to the kanban directory (not serialized).
 	dir string `yaml:"-"`
+
+	// sources maps keys merged from the global defaults file to
+	// SourceGlobal (not serialized).
+	sources map[string]string `yaml:"-"`
 }
 
 // BoardConfig holds board metadata.
@@ -46,9 +68,44 @@ type BoardConfig struct {
 
 // DefaultsConfig holds default values for new tasks.
 type DefaultsConfig struct {
-	Status   string `yaml:"status"`
-	Priority string `yaml:"priority"`
-	Class    string `yaml:"class,omitempty"`
+	Status   string       `yaml:"status"`
+	Priority string       `yaml:"priority"`
+	Class    string       `yaml:"class,omitempty"`
+	List     ListDefaults `yaml:"list,omitempty"`
+}
+
+// ListDefaults holds defaults for list flags that were not set explicitly.
+type ListDefaults struct {
+	Sort            string   `yaml:"sort,omitempty"`
+	Reverse         bool     `yaml:"reverse,omitempty"`
+	ExcludeStatuses []string `yaml:"exclude_statuses,omitempty"`
+	Limit           int      `yaml:"limit,omitempty"`
+}
+
+// SortFields lists the task fields that can be sorted on.
+var SortFields = []string{"id", "status", "priority", "created", "updated", "due"}
+
+// SortKey is one field of a composite sort spec.
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSortSpec parses a comma-separated sort spec such as
+// "priority,due,-created", where a leading "-" sorts that field descending,
+// checking each field against SortFields.
+func ParseSortSpec(spec string) ([]SortKey, error) {
+	parts := strings.Split(spec, ",")
+	keys := make([]SortKey, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		k := SortKey{Field: strings.TrimPrefix(p, "-"), Desc: strings.HasPrefix(p, "-")}
+		if !contains(SortFields, k.Field) {
+			return nil, fmt.Errorf("invalid sort field %q; valid: %s", k.Field, strings.Join(SortFields, ", "))
+		}
+		keys = append(keys, k)
+	}
+	return keys, nil
 }
 
 // AgeThreshold maps a duration threshold to an ANSI color code.
@@ -58,6 +115,22 @@ type AgeThreshold struct {
 	Color string `yaml:"color" json:"color"` // ANSI 256 color code, e.g. "34", "226", "196"
 }
 
+// TasksConfig holds task file settings.
+type TasksConfig struct {
+	FilenameFormat string `yaml:"filename_format,omitempty"` // see ParseFilenameFormat
+}
+
+// LimitsConfig holds size limits that protect board performance.
+type LimitsConfig struct {
+	MaxBodyBytes int `yaml:"max_body_bytes,omitempty"` // 0 = DefaultMaxBodyBytes
+	MaxHistory   int `yaml:"max_history,omitempty"`    // transitions kept per task; 0 = DefaultMaxHistory
+}
+
+// LogConfig holds activity log settings.
+type LogConfig struct {
+	MaxFiles int `yaml:"max_files,omitempty"` // rotated log files to keep; 0 = unlimited
+}
+
 // TUIConfig holds TUI-specific display settings.
 type TUIConfig struct {
 	TitleLines    int            `yaml:"title_lines,omitempty"`
@@ -67,9 +140,11 @@ type TUIConfig struct {
 
 // StatusConfig defines a status column and its enforcement rules.
 type StatusConfig struct {
-	Name         string `yaml:"name" json:"name"`
-	RequireClaim bool   `yaml:"require_claim,omitempty" json:"require_claim,omitempty"`
-	ShowDuration *bool  `yaml:"show_duration,omitempty" json:"show_duration,omitempty"`
+	Name         string   `yaml:"name" json:"name"`
+	RequireClaim bool     `yaml:"require_claim,omitempty" json:"require_claim,omitempty"`
+	ShowDuration *bool    `yaml:"show_duration,omitempty" json:"show_duration,omitempty"`
+	Terminal     bool     `yaml:"terminal,omitempty" json:"terminal,omitempty"` // marks a done status
+	Aliases      []string `yaml:"aliases,omitempty" json:"aliases,omitempty"`   // alternative names accepted as input
 }
 
 // UnmarshalYAML allows StatusConfig to be parsed from either a plain string
@@ -84,11 +159,42 @@ func (s *StatusConfig) UnmarshalYAML(value *yaml.Node) error {
 	return value.Decode((*plain)(s))
 }
 
+// PriorityConfig defines a priority and its display color.
+type PriorityConfig struct {
+	Name  string `yaml:"name" json:"name"`
+	Color string `yaml:"color,omitempty" json:"color,omitempty"` // ANSI 256 color code, e.g. "196"
+}
+
+// UnmarshalYAML allows PriorityConfig to be parsed from either a plain string
+// (e.g. "high") or a mapping (e.g. {name: high, color: "208"}).
+func (p *PriorityConfig) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		p.Name = value.Value
+		return nil
+	}
+	type plain PriorityConfig
+	return value.Decode((*plain)(p))
+}
+
+// MarshalYAML writes a priority without a color as a plain string, so boards
+// that never set colors keep their original format.
+func (p PriorityConfig) MarshalYAML() (any, error) {
+	if p.Color == "" {
+		return p.Name, nil
+	}
+	type plain PriorityConfig
+	return plain(p), nil
+}
+
 // ClassConfig defines a class of service and its WIP rules.
 type ClassConfig struct {
 	Name            string `yaml:"name" json:"name"`
 	WIPLimit        int    `yaml:"wip_limit,omitempty" json:"wip_limit,omitempty"`
 	BypassColumnWIP bool   `yaml:"bypass_column_wip,omitempty" json:"bypass_column_wip,omitempty"`
+	RequireDue      bool   `yaml:"require_due,omitempty" json:"require_due,omitempty"`
+	// EscalateBefore flags tasks as at risk this long before their due date,
+	// e.g. "72h" or "3d".
+	EscalateBefore string `yaml:"escalate_before,omitempty" json:"escalate_before,omitempty"`
 }
 
 // Dir returns the absolute path to the kanban directory.
@@ -106,17 +212,22 @@ func (c *Config) ConfigPath() string {
 	return filepath.Join(c.dir, ConfigFileName)
 }
 
-// NewDefault creates a Config with default values.
+// NewDefault creates a Config with default values. Keys that the global
+// defaults file can provide (claim_timeout, tui.*, priority colors) are left
+// unset so that file applies; their accessors fall back to the built-in
+// defaults.
 func NewDefault(name string) *Config {
+	priorities := make([]PriorityConfig, len(DefaultPriorities))
+	for i, p := range DefaultPriorities {
+		priorities[i] = PriorityConfig{Name: p.Name}
+	}
 	return &Config{
-		Version:      CurrentVersion,
-		Board:        BoardConfig{Name: name},
-		TasksDir:     DefaultTasksDir,
-		Statuses:     append([]StatusConfig{}, DefaultStatuses...),
-		Priorities:   append([]string{}, DefaultPriorities...),
-		Classes:      append([]ClassConfig{}, DefaultClasses...),
-		ClaimTimeout: DefaultClaimTimeout,
-		TUI:          TUIConfig{TitleLines: DefaultTitleLines, AgeThresholds: append([]AgeThreshold{}, DefaultAgeThresholds...)},
+		Version:    CurrentVersion,
+		Board:      BoardConfig{Name: name},
+		TasksDir:   DefaultTasksDir,
+		Statuses:   append([]StatusConfig{}, DefaultStatuses...),
+		Priorities: priorities,
+		Classes:    append([]ClassConfig{}, DefaultClasses...),
 		Defaults: DefaultsConfig{
 			Status:   DefaultStatus,
 			Priority: DefaultPriority,
@@ -140,6 +251,34 @@ func (c *Config) StatusNames() []string {
 	return names
 }
 
+// PriorityNames returns the ordered list of priority names.
+func (c *Config) PriorityNames() []string {
+	names := make([]string, len(c.Priorities))
+	for i, p := range c.Priorities {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// PriorityColor returns the configured color for a priority. A priority
+// without a color falls back to the built-in color for its name; "" means
+// the priority is unknown or has no color.
+func (c *Config) PriorityColor(name string) string {
+	i := c.PriorityIndex(name)
+	if i < 0 {
+		return ""
+	}
+	if color := c.Priorities[i].Color; color != "" {
+		return color
+	}
+	for _, p := range DefaultPriorities {
+		if p.Name == name {
+			return p.Color
+		}
+	}
+	return ""
+}
+
 // StatusRequiresClaim returns true if the given status has require_claim set.
 func (c *Config) StatusRequiresClaim(status string) bool {
 	for _, s := range c.Statuses {
@@ -182,30 +321,56 @@ func (c *Config) Validate() error {
 	if hasDuplicates(names) {
 		return fmt.Errorf("%w: statuses contain duplicates", ErrInvalid)
 	}
-	if len(c.Priorities) < 1 {
+	if err := c.validateStatusAliases(); err != nil {
+		return err
+	}
+	priorities := c.PriorityNames()
+	if len(priorities) < 1 {
 		return fmt.Errorf("%w: at least 1 priority is required", ErrInvalid)
 	}
-	if hasDuplicates(c.Priorities) {
+	if hasDuplicates(priorities) {
 		return fmt.Errorf("%w: priorities contain duplicates", ErrInvalid)
 	}
 	if !contains(names, c.Defaults.Status) {
 		return fmt.Errorf("%w: default status %q not in statuses list", ErrInvalid, c.Defaults.Status)
 	}
-	if !contains(c.Priorities, c.Defaults.Priority) {
+	if !contains(priorities, c.Defaults.Priority) {
 		return fmt.Errorf("%w: default priority %q not in priorities list", ErrInvalid, c.Defaults.Priority)
 	}
 	if err := c.validateWIPLimits(); err != nil {
 		return err
 	}
+	if err := c.validateListDefaults(); err != nil {
+		return err
+	}
 	if err := c.validateClasses(); err != nil {
 		return err
 	}
 	if err := c.validateClaimTimeout(); err != nil {
 		return err
 	}
+	if c.AutoArchiveAfter != "" {
+		if _, err := date.ParseDuration(c.AutoArchiveAfter); err != nil {
+			return fmt.Errorf("%w: auto_archive_after: %w", ErrInvalid, err)
+		}
+	}
 	if err := c.validateTUI(); err != nil {
 		return err
 	}
+	if c.Tasks.FilenameFormat != "" {
+		if _, err := ParseFilenameFormat(c.Tasks.FilenameFormat); err != nil {
+			return err
+		}
+	}
+	if c.Limits.MaxBodyBytes < 0 {
+		return fmt.Errorf("%w: limits.max_body_bytes must be >= 0", ErrInvalid)
+	}
+	if c.Limits.MaxHistory < 0 {
+		return fmt.Errorf("%w: limits.max_history must be >= 0", ErrInvalid)
+	}
+	if c.Log.MaxFiles < 0 {
+		return fmt.Errorf("%w: log.max_files must be >= 0", ErrInvalid)
+	}
 	if c.NextID < 1 {
 		return fmt.Errorf("%w: next_id must be >= 1", ErrInvalid)
 	}
@@ -213,15 +378,44 @@ func (c *Config) Validate() error {
 }
 
 func (c *Config) validateWIPLimits() error {
-	names := c.StatusNames()
-	for status, limit := range c.WIPLimits {
-		if !contains(names, status) {
-			return fmt.Errorf("%w: wip_limits references unknown status %q", ErrInvalid, status)
+	if err := validateCountMap("wip_limits", "status", c.WIPLimits, c.StatusNames()); err != nil {
+		return err
+	}
+	if err := validateCountMap("priority_wip_limits", "priority", c.PriorityWIPLimits, c.PriorityNames()); err != nil {
+		return err
+	}
+	return validateCountMap("wip_minimums", "status", c.WIPMinimums, c.StatusNames())
+}
+
+// validateCountMap checks that every key of m is in allowed and every value
+// is non-negative.
+func validateCountMap(key, kind string, m map[string]int, allowed []string) error {
+	for name, n := range m {
+		if !contains(allowed, name) {
+			return fmt.Errorf("%w: %s references unknown %s %q", ErrInvalid, key, kind, name)
+		}
+		if n < 0 {
+			return fmt.Errorf("%w: %s for %q must be >= 0", ErrInvalid, key, name)
 		}
-		if limit < 0 {
-			return fmt.Errorf("%w: wip_limits for %q must be >= 0", ErrInvalid, status)
+	}
+	return nil
+}
+
+func (c *Config) validateListDefaults() error {
+	d := c.Defaults.List
+	if d.Sort != "" {
+		if _, err := ParseSortSpec(d.Sort); err != nil {
+			return fmt.Errorf("%w: defaults.list.sort: %w", ErrInvalid, err)
+		}
+	}
+	for _, status := range d.ExcludeStatuses {
+		if c.StatusIndex(status) < 0 {
+			return fmt.Errorf("%w: defaults.list.exclude_statuses references unknown status %q", ErrInvalid, status)
 		}
 	}
+	if d.Limit < 0 {
+		return fmt.Errorf("%w: defaults.list.limit must be >= 0", ErrInvalid)
+	}
 	return nil
 }
 
@@ -241,6 +435,11 @@ func (c *Config) validateClasses() error {
 		if cl.WIPLimit < 0 {
 			return fmt.Errorf("%w: class %q wip_limit must be >= 0", ErrInvalid, cl.Name)
 		}
+		if cl.EscalateBefore != "" {
+			if _, err := date.ParseDuration(cl.EscalateBefore); err != nil {
+				return fmt.Errorf("%w: class %q escalate_before: %w", ErrInvalid, cl.Name, err)
+			}
+		}
 	}
 	if c.Defaults.Class != "" && !seen[c.Defaults.Class] {
 		return fmt.Errorf("%w: default class %q not in classes list", ErrInvalid, c.Defaults.Class)
@@ -259,7 +458,7 @@ func (c *Config) validateClaimTimeout() error {
 
 func (c *Config) validateTUI() error {
 	const minTitleLines, maxTitleLines = 1, 3
-	if c.TUI.TitleLines < minTitleLines || c.TUI.TitleLines > maxTitleLines {
+	if c.TUI.TitleLines != 0 && (c.TUI.TitleLines < minTitleLines || c.TUI.TitleLines > maxTitleLines) {
 		return fmt.Errorf("%w: tui.title_lines must be between %d and %d",
 			ErrInvalid, minTitleLines, maxTitleLines)
 	}
@@ -284,10 +483,7 @@ func (c *Config) AgeThresholdsDuration() []struct {
 	After time.Duration
 	Color string
 } {
-	thresholds := c.TUI.AgeThresholds
-	if len(thresholds) == 0 {
-		thresholds = DefaultAgeThresholds
-	}
+	thresholds := c.AgeThresholds()
 	result := make([]struct {
 		After time.Duration
 		Color string
@@ -313,19 +509,79 @@ func (c *Config) WIPLimit(status string) int {
 	return c.WIPLimits[status]
 }
 
-// ClaimTimeoutDuration parses the claim_timeout string into a time.Duration.
-// Returns 0 (no expiry) if the field is empty or unparseable.
-func (c *Config) ClaimTimeoutDuration() time.Duration {
-	if c.ClaimTimeout == "" {
+// ClassEscalation returns the escalate_before window of a class, or 0 when
+// the class is unknown or has none.
+func (c *Config) ClassEscalation(class string) time.Duration {
+	cl := c.ClassByName(class)
+	if cl == nil || cl.EscalateBefore == "" {
 		return 0
 	}
-	d, err := time.ParseDuration(c.ClaimTimeout)
+	d, _ := date.ParseDuration(cl.EscalateBefore) // validated on load
+	return d
+}
+
+// PriorityWIPLimit returns the board-wide in-flight limit for a priority, or
+// 0 (unlimited).
+func (c *Config) PriorityWIPLimit(priority string) int {
+	return c.PriorityWIPLimits[priority]
+}
+
+// WIPMinimum returns the replenishment minimum for a status, or 0 (none).
+func (c *Config) WIPMinimum(status string) int {
+	return c.WIPMinimums[status]
+}
+
+// IsInitialStatus reports whether status is where work has not started yet:
+// the first column or the configured default status.
+func (c *Config) IsInitialStatus(status string) bool {
+	return c.StatusIndex(status) == 0 || status == c.Defaults.Status
+}
+
+// IsInFlightStatus reports whether tasks in status count as in flight for
+// priority WIP limits: known statuses that are neither initial nor terminal.
+func (c *Config) IsInFlightStatus(status string) bool {
+	return c.StatusIndex(status) >= 0 && !c.IsInitialStatus(status) && !c.IsTerminalStatus(status)
+}
+
+// ClaimTimeoutValue returns the claim_timeout string, or DefaultClaimTimeout
+// when it is unset.
+func (c *Config) ClaimTimeoutValue() string {
+	if c.ClaimTimeout == "" {
+		return DefaultClaimTimeout
+	}
+	return c.ClaimTimeout
+}
+
+// ClaimTimeoutDuration parses claim_timeout into a time.Duration, using
+// DefaultClaimTimeout when it is unset. "0" disables claim expiry; an
+// unparseable value also yields 0.
+func (c *Config) ClaimTimeoutDuration() time.Duration {
+	d, err := time.ParseDuration(c.ClaimTimeoutValue())
 	if err != nil {
 		return 0
 	}
 	return d
 }
 
+// AgeThresholds returns the configured TUI age thresholds, or
+// DefaultAgeThresholds when none are set.
+func (c *Config) AgeThresholds() []AgeThreshold {
+	if len(c.TUI.AgeThresholds) == 0 {
+		return DefaultAgeThresholds
+	}
+	return c.TUI.AgeThresholds
+}
+
+// AutoArchiveDuration returns the auto_archive_after threshold, or 0 when
+// auto-archiving is off.
+func (c *Config) AutoArchiveDuration() time.Duration {
+	if c.AutoArchiveAfter == "" {
+		return 0
+	}
+	d, _ := date.ParseDuration(c.AutoArchiveAfter) // validated on load
+	return d
+}
+
 // TitleLines returns the configured number of title lines for TUI cards.
 // Returns DefaultTitleLines if the value is unset (zero).
 func (c *Config) TitleLines() int {
@@ -390,6 +646,9 @@ func Init(dir, name string) (*Config, error) {
 	if err := cfg.Save(); err != nil {
 		return nil, fmt.Errorf("writing config: %w", err)
 	}
+	if err := mergeGlobal(cfg); err != nil {
+		return nil, err
+	}
 
 	return cfg, nil
 }
@@ -411,7 +670,7 @@ func InitAgent(dir string) (*Config, error) {
 		{Name: "In Progress"},
 		{Name: "PermissionRequest"},
 		{Name: "Waiting"},
-		{Name: "Finished"},
+		{Name: "Finished", Terminal: true},
 	}
 	cfg.Defaults.Status = "Idle"
 
@@ -422,21 +681,44 @@ func InitAgent(dir string) (*Config, error) {
 	if err := cfg.Save(); err != nil {
 		return nil, fmt.Errorf("writing config: %w", err)
 	}
+	if err := mergeGlobal(cfg); err != nil {
+		return nil, err
+	}
 
 	return cfg, nil
 }
 
+// Marshal returns the config serialized as YAML. Values merged from the
+// global defaults file are omitted.
+func (c *Config) Marshal() ([]byte, error) {
+	data, err := yaml.Marshal(c.boardOnly())
+	if err != nil {
+		return nil, fmt.Errorf("marshaling config: %w", err)
+	}
+	return data, nil
+}
+
 // Save writes the config to its config file.
 func (c *Config) Save() error {
-	data, err := yaml.Marshal(c)
+	data, err := c.Marshal()
 	if err != nil {
-		return fmt.Errorf("marshaling config: %w", err)
+		return err
 	}
-	return os.WriteFile(c.ConfigPath(), data, fileMode)
+	return writeFileAtomic(c.ConfigPath(), data)
 }
 
-// Load reads and validates a config from the given kanban directory.
-func Load(dir string) (*Config, error) {
+// writeFileAtomic writes data via a synced temporary file and rename, so a
+// crash never leaves a truncated config.
+func writeFileAtomic(path string, data []byte) error {
+	if err := atomicfile.Write(path, data, fileMode); err != nil {
+		return fmt.Errorf("writing config: %w", err)
+	}
+	return nil
+}
+
+// LoadRaw reads a config from the given kanban directory as stored on disk,
+// without migrating or validating it.
+func LoadRaw(dir string) (*Config, error) {
 	absDir, err := filepath.Abs(dir)
 	if err != nil {
 		return nil, fmt.Errorf("resolving path: %w", err)
@@ -457,10 +739,52 @@ func Load(dir string) (*Config, error) {
 	}
 
 	cfg.dir = absDir
+	return &cfg, nil
+}
+
+// AsMap returns the board's own config, without global defaults, as a
+// generic map keyed like config.yml. It is used to embed the config in other
+// documents such as board snapshots.
+func (c *Config) AsMap() (map[string]any, error) {
+	data, err := c.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("decoding config: %w", err)
+	}
+	return m, nil
+}
+
+// FromMap builds a config from a map produced by AsMap and migrates it to
+// the current version. The result is not validated and has no directory set.
+func FromMap(m map[string]any) (*Config, error) {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("encoding config: %w", err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+	if err := migrate(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Load reads a config from the given kanban directory, layers the global
+// defaults file underneath it and validates the merged result.
+func Load(dir string) (*Config, error) {
+	cfg, err := LoadRaw(dir)
+	if err != nil {
+		return nil, err
+	}
 
 	// Migrate old config versions forward before validating.
 	oldVersion := cfg.Version
-	if err := migrate(&cfg); err != nil {
+	if err := migrate(cfg); err != nil {
 		return nil, err
 	}
 
@@ -471,11 +795,16 @@ func Load(dir string) (*Config, error) {
 		}
 	}
 
+	// Layer user-wide defaults underneath the board's own values.
+	if err := mergeGlobal(cfg); err != nil {
+		return nil, err
+	}
+
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
 
-	return &cfg, nil
+	return cfg, nil
 }
 
 // FindDir walks upward from startDir looking for a kanban directory
@@ -509,22 +838,51 @@ func FindDir(startDir string) (string, error) {
 }
 
 // IsTerminalStatus returns true if the given status is a terminal status.
-// Both the "done" status (immediately before archived) and "archived" itself
-// are considered terminal. If the board has no archived status, the last
-// status is terminal (backward-compatible behavior).
+// The archived status is always terminal. Statuses marked terminal: true are
+// terminal; if none are marked, the status immediately before archived (or
+// the last status, if the board has no archived status) is.
 func (c *Config) IsTerminalStatus(s string) bool {
-	names := c.StatusNames()
-	if len(names) == 0 {
-		return false
-	}
 	if s == ArchivedStatus {
 		return true
 	}
+	marked := false
+	for _, sc := range c.Statuses {
+		if sc.Terminal {
+			if sc.Name == s {
+				return true
+			}
+			marked = true
+		}
+	}
+	if marked {
+		return false
+	}
+	done := inferredDoneStatus(c.StatusNames())
+	return done != "" && s == done
+}
+
+// inferredDoneStatus returns the positional done status: the one before
+// archived, or the last status if the board has no archived status.
+func inferredDoneStatus(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
 	lastIdx := len(names) - 1
 	if names[lastIdx] == ArchivedStatus && lastIdx > 0 {
-		return s == names[lastIdx-1]
+		return names[lastIdx-1]
 	}
-	return s == names[lastIdx]
+	return names[lastIdx]
+}
+
+// TerminalStatuses returns the statuses for which IsTerminalStatus is true.
+func (c *Config) TerminalStatuses() []string {
+	var result []string
+	for _, s := range c.StatusNames() {
+		if c.IsTerminalStatus(s) {
+			result = append(result, s)
+		}
+	}
+	return result
 }
 
 // IsArchivedStatus returns true if the given status is the archived status.
@@ -559,6 +917,22 @@ func (c *Config) ActiveStatuses() []string {
 	return result
 }
 
+// MaxBodyBytes returns the maximum task body size in bytes.
+func (c *Config) MaxBodyBytes() int {
+	if c.Limits.MaxBodyBytes > 0 {
+		return c.Limits.MaxBodyBytes
+	}
+	return DefaultMaxBodyBytes
+}
+
+// MaxHistory returns how many status transitions a task file keeps.
+func (c *Config) MaxHistory() int {
+	if c.Limits.MaxHistory > 0 {
+		return c.Limits.MaxHistory
+	}
+	return DefaultMaxHistory
+}
+
 // StatusIndex returns the index of a status in the configured order, or -1.
 func (c *Config) StatusIndex(status string) int {
 	return IndexOf(c.StatusNames(), status)
@@ -566,7 +940,7 @@ func (c *Config) StatusIndex(status string) int {
 
 // PriorityIndex returns the index of a priority in the configured order, or -1.
 func (c *Config) PriorityIndex(priority string) int {
-	return IndexOf(c.Priorities, priority)
+	return IndexOf(c.PriorityNames(), priority)
 }
 
 func contains(slice []string, item string) bool {
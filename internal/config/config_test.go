@@ -0,0 +1,49 @@
+package config
+
+import "testing"
+
+func TestIsTerminalStatus(t *testing.T) {
+	statuses := func(terminal ...string) []StatusConfig {
+		var out []StatusConfig
+		for _, name := range []string{"todo", "doing", "done", "cancelled", ArchivedStatus} {
+			sc := StatusConfig{Name: name}
+			for _, term := range terminal {
+				sc.Terminal = sc.Terminal || term == name
+			}
+			out = append(out, sc)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		terminal []string
+		want     map[string]bool
+	}{
+		{
+			"two terminal statuses",
+			[]string{"done", "cancelled"},
+			map[string]bool{"todo": false, "doing": false, "done": true, "cancelled": true, ArchivedStatus: true},
+		},
+		{
+			"one marked status overrides position",
+			[]string{"done"},
+			map[string]bool{"todo": false, "doing": false, "done": true, "cancelled": false, ArchivedStatus: true},
+		},
+		{
+			"none marked infers the status before archived",
+			nil,
+			map[string]bool{"todo": false, "doing": false, "done": false, "cancelled": true, ArchivedStatus: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{Statuses: statuses(tt.terminal...)}
+			for status, want := range tt.want {
+				if got := c.IsTerminalStatus(status); got != want {
+					t.Errorf("IsTerminalStatus(%q) = %v, want %v", status, got, want)
+				}
+			}
+		})
+	}
+}
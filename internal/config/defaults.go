@@ -16,12 +16,17 @@ const (
 	DefaultClaimTimeout = "1h"
 	// DefaultTitleLines is the default number of title lines in TUI cards.
 	DefaultTitleLines = 2
+	// DefaultMaxBodyBytes is the default maximum task body size (1 MiB).
+	DefaultMaxBodyBytes = 1 << 20
+	// DefaultMaxHistory is the default number of status transitions kept in
+	// each task file.
+	DefaultMaxHistory = 50
 
 	// ConfigFileName is the name of the config file within the kanban directory.
 	ConfigFileName = "config.yml"
 
 	// CurrentVersion is the current config schema version.
-	CurrentVersion = 9
+	CurrentVersion = 12
 
 	// ArchivedStatus is the reserved status name for soft-deleted tasks.
 	ArchivedStatus = "archived"
@@ -34,15 +39,15 @@ var (
 		{Name: "todo"},
 		{Name: "in-progress", RequireClaim: true},
 		{Name: "review", RequireClaim: true},
-		{Name: "done", ShowDuration: boolPtr(false)},
+		{Name: "done", ShowDuration: boolPtr(false), Terminal: true},
 		{Name: ArchivedStatus, ShowDuration: boolPtr(false)},
 	}
 
-	DefaultPriorities = []string{
-		"low",
-		"medium",
-		"high",
-		"critical",
+	DefaultPriorities = []PriorityConfig{
+		{Name: "low", Color: "242"},      // dim gray
+		{Name: "medium", Color: "226"},   // yellow
+		{Name: "high", Color: "208"},     // orange
+		{Name: "critical", Color: "196"}, // red
 	}
 
 	// DefaultAgeThresholds defines the default progressive color thresholds
@@ -59,7 +64,7 @@ var (
 	// DefaultClasses defines the default classes of service.
 	DefaultClasses = []ClassConfig{
 		{Name: "expedite", WIPLimit: 1, BypassColumnWIP: true},
-		{Name: "fixed-date"},
+		{Name: "fixed-date", RequireDue: true, EscalateBefore: "3d"},
 		{Name: "standard"},
 		{Name: "intangible"},
 	}
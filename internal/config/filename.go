@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultFilenameFormat is the task filename template used when
+// tasks.filename_format is unset.
+const DefaultFilenameFormat = "{id:03d}-{slug}"
+
+// FilenameFormat is a parsed tasks.filename_format template. Placeholders:
+// {id} or {id:0Nd} (zero-padded to N digits), {slug}, and {date} (the
+// task's creation date, YYYY-MM-DD). The ".md" extension is implied.
+type FilenameFormat struct {
+	parts []filenamePart
+	re    *regexp.Regexp // matches a rendered filename, capturing the ID
+}
+
+type filenamePart struct {
+	literal string
+	field   string // "id", "slug", "date"; empty for literals
+	pad     int    // zero-padding width for id
+}
+
+var placeholderRe = regexp.MustCompile(`\{([a-z]+)(?::0(\d)d)?\}`)
+
+// ParseFilenameFormat parses a filename template. It must contain exactly
+// one {id} placeholder and no path separators.
+func ParseFilenameFormat(s string) (*FilenameFormat, error) {
+	s = strings.TrimSuffix(s, ".md")
+	if strings.ContainsAny(s, `/\`) {
+		return nil, fmt.Errorf("%w: tasks.filename_format %q must not contain path separators", ErrInvalid, s)
+	}
+
+	f := &FilenameFormat{}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	ids := 0
+	last := 0
+	for _, m := range placeholderRe.FindAllStringSubmatchIndex(s, -1) {
+		if m[0] > last {
+			lit := s[last:m[0]]
+			f.parts = append(f.parts, filenamePart{literal: lit})
+			pattern.WriteString(regexp.QuoteMeta(lit))
+		}
+		last = m[1]
+
+		p := filenamePart{field: s[m[2]:m[3]]}
+		if m[4] >= 0 {
+			if p.field != "id" {
+				return nil, fmt.Errorf("%w: tasks.filename_format: only {id} takes a width", ErrInvalid)
+			}
+			p.pad, _ = strconv.Atoi(s[m[4]:m[5]])
+		}
+		switch p.field {
+		case "id":
+			ids++
+			pattern.WriteString(`(\d+)`)
+		case "slug":
+			pattern.WriteString(`.*?`)
+		case "date":
+			pattern.WriteString(`\d{4}-\d{2}-\d{2}`)
+		default:
+			return nil, fmt.Errorf("%w: tasks.filename_format: unknown placeholder {%s}", ErrInvalid, p.field)
+		}
+		f.parts = append(f.parts, p)
+	}
+	if last < len(s) {
+		lit := s[last:]
+		f.parts = append(f.parts, filenamePart{literal: lit})
+		pattern.WriteString(regexp.QuoteMeta(lit))
+	}
+	if strings.ContainsAny(joinLiterals(f.parts), "{}") {
+		return nil, fmt.Errorf("%w: tasks.filename_format %q has a malformed placeholder", ErrInvalid, s)
+	}
+	if ids != 1 {
+		return nil, fmt.Errorf("%w: tasks.filename_format %q must contain exactly one {id} placeholder", ErrInvalid, s)
+	}
+	pattern.WriteString(`\.md$`)
+	f.re = regexp.MustCompile(pattern.String())
+	return f, nil
+}
+
+func joinLiterals(parts []filenamePart) string {
+	var b strings.Builder
+	for _, p := range parts {
+		b.WriteString(p.literal)
+	}
+	return b.String()
+}
+
+// Render returns the filename for a task.
+func (f *FilenameFormat) Render(id int, slug string, created time.Time) string {
+	var b strings.Builder
+	for _, p := range f.parts {
+		switch p.field {
+		case "id":
+			fmt.Fprintf(&b, "%0*d", p.pad, id)
+		case "slug":
+			b.WriteString(slug)
+		case "date":
+			b.WriteString(created.Format("2006-01-02"))
+		default:
+			b.WriteString(p.literal)
+		}
+	}
+	b.WriteString(".md")
+	return b.String()
+}
+
+// ExtractID returns the task ID from a filename rendered by this format.
+func (f *FilenameFormat) ExtractID(filename string) (int, bool) {
+	m := f.re.FindStringSubmatch(filename)
+	if m == nil {
+		return 0, false
+	}
+	id, err := strconv.Atoi(m[1])
+	return id, err == nil
+}
+
+// FilenameFormat returns the parsed tasks.filename_format, or the default
+// format if it is unset. The config must have been validated.
+func (c *Config) FilenameFormat() *FilenameFormat {
+	s := c.Tasks.FilenameFormat
+	if s == "" {
+		s = DefaultFilenameFormat
+	}
+	f, err := ParseFilenameFormat(s)
+	if err != nil {
+		f, _ = ParseFilenameFormat(DefaultFilenameFormat)
+	}
+	return f
+}
@@ -0,0 +1,273 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// GlobalFileName is the name of the user-wide defaults file.
+const GlobalFileName = "defaults.yml"
+
+// Config value sources reported by Source.
+const (
+	SourceBoard  = "board"
+	SourceGlobal = "global"
+)
+
+// Global holds user-wide defaults layered underneath every board's
+// config.yml. Only presentation and policy keys can be set here; statuses,
+// next_id and tasks_dir always belong to the board.
+type Global struct {
+	ClaimTimeout string       `yaml:"claim_timeout,omitempty"`
+	TUI          TUIConfig    `yaml:"tui,omitempty"`
+	Limits       LimitsConfig `yaml:"limits,omitempty"`
+	// PriorityColors maps priority names to colors. It applies to a board
+	// as a whole, and only when none of the board's priorities has a color.
+	PriorityColors map[string]string `yaml:"priority_colors,omitempty"`
+
+	path string `yaml:"-"`
+}
+
+// globalKeys lists the config keys a Global may provide, with accessors for
+// the layer values. A value is unset when isSet reports false.
+var globalKeys = []struct {
+	key   string
+	isSet func(*Global) bool
+	copy  func(dst *Config, src *Global)
+	save  func(dst *Global, src *Config)
+	clear func(*Config)
+}{
+	{
+		key:   "claim_timeout",
+		isSet: func(g *Global) bool { return g.ClaimTimeout != "" },
+		copy:  func(c *Config, g *Global) { c.ClaimTimeout = g.ClaimTimeout },
+		save:  func(g *Global, c *Config) { g.ClaimTimeout = c.ClaimTimeout },
+		clear: func(c *Config) { c.ClaimTimeout = "" },
+	},
+	{
+		key:   "tui.title_lines",
+		isSet: func(g *Global) bool { return g.TUI.TitleLines != 0 },
+		copy:  func(c *Config, g *Global) { c.TUI.TitleLines = g.TUI.TitleLines },
+		save:  func(g *Global, c *Config) { g.TUI.TitleLines = c.TUI.TitleLines },
+		clear: func(c *Config) { c.TUI.TitleLines = 0 },
+	},
+	{
+		key:   "tui.body_lines",
+		isSet: func(g *Global) bool { return g.TUI.BodyLines != 0 },
+		copy:  func(c *Config, g *Global) { c.TUI.BodyLines = g.TUI.BodyLines },
+		save:  func(g *Global, c *Config) { g.TUI.BodyLines = c.TUI.BodyLines },
+		clear: func(c *Config) { c.TUI.BodyLines = 0 },
+	},
+	{
+		key:   "tui.age_thresholds",
+		isSet: func(g *Global) bool { return len(g.TUI.AgeThresholds) > 0 },
+		copy:  func(c *Config, g *Global) { c.TUI.AgeThresholds = append([]AgeThreshold{}, g.TUI.AgeThresholds...) },
+		save:  func(g *Global, c *Config) { g.TUI.AgeThresholds = c.TUI.AgeThresholds },
+		clear: func(c *Config) { c.TUI.AgeThresholds = nil },
+	},
+	{
+		key:   "limits.max_body_bytes",
+		isSet: func(g *Global) bool { return g.Limits.MaxBodyBytes != 0 },
+		copy:  func(c *Config, g *Global) { c.Limits.MaxBodyBytes = g.Limits.MaxBodyBytes },
+		save:  func(g *Global, c *Config) { g.Limits.MaxBodyBytes = c.Limits.MaxBodyBytes },
+		clear: func(c *Config) { c.Limits.MaxBodyBytes = 0 },
+	},
+	{
+		key:   "priority_colors",
+		isSet: func(g *Global) bool { return len(g.PriorityColors) > 0 },
+		copy: func(c *Config, g *Global) {
+			for i, p := range c.Priorities {
+				c.Priorities[i].Color = g.PriorityColors[p.Name]
+			}
+		},
+		save: func(g *Global, c *Config) { g.PriorityColors = priorityColors(c) },
+		clear: func(c *Config) {
+			c.Priorities = slices.Clone(c.Priorities)
+			for i := range c.Priorities {
+				c.Priorities[i].Color = ""
+			}
+		},
+	},
+}
+
+// priorityColors returns the colors set on c's priorities by name, or nil
+// if none has a color.
+func priorityColors(c *Config) map[string]string {
+	var colors map[string]string
+	for _, p := range c.Priorities {
+		if p.Color == "" {
+			continue
+		}
+		if colors == nil {
+			colors = make(map[string]string)
+		}
+		colors[p.Name] = p.Color
+	}
+	return colors
+}
+
+// IsGlobalKey reports whether key may be set in the global defaults file.
+func IsGlobalKey(key string) bool {
+	for _, gk := range globalKeys {
+		if gk.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// GlobalKeys returns the keys that may be set in the global defaults file.
+func GlobalKeys() []string {
+	keys := make([]string, len(globalKeys))
+	for i, gk := range globalKeys {
+		keys[i] = gk.key
+	}
+	return keys
+}
+
+// GlobalPath returns the path of the global defaults file:
+// $XDG_CONFIG_HOME/agentwatch/defaults.yml, falling back to ~/.config.
+func GlobalPath() (string, error) {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("locating home directory: %w", err)
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, "agentwatch", GlobalFileName), nil
+}
+
+// LoadGlobal reads the global defaults file. A missing file yields empty
+// defaults.
+func LoadGlobal() (*Global, error) {
+	path, err := GlobalPath()
+	if err != nil {
+		return nil, err
+	}
+	g := &Global{path: path}
+
+	data, err := os.ReadFile(path) //nolint:gosec // path from the user's config dir
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return g, nil
+		}
+		return nil, fmt.Errorf("reading global defaults: %w", err)
+	}
+	if err := yaml.Unmarshal(data, g); err != nil {
+		return nil, fmt.Errorf("%w: parsing %s: %w", ErrInvalid, path, err)
+	}
+	return g, nil
+}
+
+// Path returns the global defaults file path.
+func (g *Global) Path() string {
+	return g.path
+}
+
+// Save writes the global defaults file, creating its directory if needed.
+func (g *Global) Save() error {
+	const dirMode = 0o750
+	if err := os.MkdirAll(filepath.Dir(g.path), dirMode); err != nil {
+		return fmt.Errorf("creating global config directory: %w", err)
+	}
+	data, err := yaml.Marshal(g)
+	if err != nil {
+		return fmt.Errorf("marshaling global defaults: %w", err)
+	}
+	return writeFileAtomic(g.path, data)
+}
+
+// Merge fills every key the board config leaves unset with the global value
+// and records it as coming from the global layer. Save omits such values, so
+// they stay in the global file.
+func (g *Global) Merge(c *Config) {
+	probe := &Global{ClaimTimeout: c.ClaimTimeout, TUI: c.TUI, Limits: c.Limits, PriorityColors: priorityColors(c)}
+	for _, gk := range globalKeys {
+		if gk.isSet(probe) || !gk.isSet(g) {
+			continue
+		}
+		gk.copy(c, g)
+		if c.sources == nil {
+			c.sources = make(map[string]string)
+		}
+		c.sources[gk.key] = SourceGlobal
+	}
+}
+
+// mergeGlobal layers the global defaults file underneath c.
+func mergeGlobal(c *Config) error {
+	global, err := LoadGlobal()
+	if err != nil {
+		return err
+	}
+	global.Merge(c)
+	return nil
+}
+
+// Preview returns a default config with the global values applied, for
+// validating and editing the global layer on its own.
+func (g *Global) Preview() *Config {
+	c := NewDefault("global")
+	for _, gk := range globalKeys {
+		if gk.isSet(g) {
+			gk.copy(c, g)
+		}
+	}
+	return c
+}
+
+// SetFrom copies key's value from c into the global layer.
+func (g *Global) SetFrom(key string, c *Config) {
+	for _, gk := range globalKeys {
+		if gk.key == key {
+			gk.save(g, c)
+		}
+	}
+}
+
+// Unset removes key from the global layer.
+func (g *Global) Unset(key string) {
+	for _, gk := range globalKeys {
+		if gk.key == key {
+			empty := &Config{}
+			gk.save(g, empty)
+		}
+	}
+}
+
+// Source reports which layer a config key's value came from: SourceGlobal
+// for values merged from the global defaults file, SourceBoard otherwise.
+func (c *Config) Source(key string) string {
+	if s, ok := c.sources[key]; ok {
+		return s
+	}
+	return SourceBoard
+}
+
+// Override marks key as owned by the board, so Save writes it to config.yml
+// even if it was merged from the global layer.
+func (c *Config) Override(key string) {
+	delete(c.sources, key)
+}
+
+// boardOnly returns a copy of c without the values merged from the global
+// layer, for saving.
+func (c *Config) boardOnly() *Config {
+	if len(c.sources) == 0 {
+		return c
+	}
+	out := *c
+	for _, gk := range globalKeys {
+		if c.sources[gk.key] == SourceGlobal {
+			gk.clear(&out)
+		}
+	}
+	return &out
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeGlobal points XDG_CONFIG_HOME at a temporary directory holding a
+// defaults.yml with content.
+func writeGlobal(t *testing.T, content string) {
+	t.Helper()
+	base := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", base)
+	if err := os.MkdirAll(filepath.Join(base, "agentwatch"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(base, "agentwatch", GlobalFileName), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGlobalAppliesToNewBoards(t *testing.T) {
+	writeGlobal(t, `claim_timeout: 5m
+tui:
+  title_lines: 3
+  age_thresholds:
+    - after: 2h
+      color: "99"
+priority_colors:
+  high: "99"
+`)
+	check := func(t *testing.T, cfg *Config) {
+		t.Helper()
+		if got := cfg.ClaimTimeoutValue(); got != "5m" {
+			t.Errorf("claim_timeout = %q, want 5m", got)
+		}
+		if got := cfg.TitleLines(); got != 3 {
+			t.Errorf("title_lines = %d, want 3", got)
+		}
+		if got := cfg.AgeThresholds(); len(got) != 1 || got[0].After != "2h" {
+			t.Errorf("age_thresholds = %+v, want the global one", got)
+		}
+		if got := cfg.PriorityColor("high"); got != "99" {
+			t.Errorf("high color = %q, want 99", got)
+		}
+		for _, key := range []string{"claim_timeout", "tui.title_lines", "tui.age_thresholds", "priority_colors"} {
+			if cfg.Source(key) != SourceGlobal {
+				t.Errorf("Source(%s) = %s, want global", key, cfg.Source(key))
+			}
+		}
+	}
+
+	for name, initFn := range map[string]func(string) (*Config, error){
+		"Init":      func(dir string) (*Config, error) { return Init(dir, "test") },
+		"InitAgent": InitAgent,
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			cfg, err := initFn(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, cfg)
+
+			data, err := os.ReadFile(cfg.ConfigPath())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range []string{"claim_timeout", "title_lines", "age_thresholds", "color"} {
+				if strings.Contains(string(data), key) {
+					t.Errorf("config.yml contains %s:\n%s", key, data)
+				}
+			}
+
+			loaded, err := Load(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			check(t, loaded)
+		})
+	}
+}
+
+func TestGlobalLeavesBoardValues(t *testing.T) {
+	writeGlobal(t, "claim_timeout: 5m\npriority_colors:\n  high: \"99\"\n")
+	dir := t.TempDir()
+	cfg, err := Init(dir, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ClaimTimeout = "2h"
+	for i := range cfg.Priorities {
+		cfg.Priorities[i].Color = ""
+	}
+	cfg.Priorities[cfg.PriorityIndex("low")].Color = "240"
+	cfg.Override("claim_timeout")
+	cfg.Override("priority_colors")
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.ClaimTimeoutValue(); got != "2h" {
+		t.Errorf("claim_timeout = %q, want the board's 2h", got)
+	}
+	// A board with any priority color keeps its own; the rest use built-ins.
+	if low, high := loaded.PriorityColor("low"), loaded.PriorityColor("high"); low != "240" || high != "208" {
+		t.Errorf("colors low=%q high=%q, want 240 and the built-in 208", low, high)
+	}
+}
@@ -2,6 +2,42 @@ package config
 
 import "fmt"
 
+// Migrate upgrades cfg in memory to CurrentVersion without saving it.
+func Migrate(cfg *Config) error {
+	return migrate(cfg)
+}
+
+// MigrationPath returns the chain of versions a config at version passes
+// through when migrated, e.g. [7 8 9]. A config already at CurrentVersion
+// yields a single-element path.
+func MigrationPath(version int) ([]int, error) {
+	if err := checkMigratable(version); err != nil {
+		return nil, err
+	}
+	path := []int{version}
+	for v := version; v < CurrentVersion; v++ {
+		if _, ok := migrations[v]; !ok {
+			return nil, fmt.Errorf("%w: no migration path from version %d", ErrInvalid, v)
+		}
+		path = append(path, v+1)
+	}
+	return path, nil
+}
+
+// checkMigratable rejects versions that cannot be migrated by this binary.
+func checkMigratable(version int) error {
+	if version > CurrentVersion {
+		return fmt.Errorf(
+			"%w: config version %d is newer than supported version %d (upgrade agentwatch)",
+			ErrInvalid, version, CurrentVersion,
+		)
+	}
+	if version < 1 {
+		return fmt.Errorf("%w: config version %d is invalid", ErrInvalid, version)
+	}
+	return nil
+}
+
 // migrate upgrades a config from its current version to CurrentVersion.
 // Each migration function transforms the config one version forward.
 // Returns nil if no migration is needed (already at current version).
@@ -10,14 +46,8 @@ func migrate(cfg *Config) error {
 	if cfg.Version == CurrentVersion {
 		return nil
 	}
-	if cfg.Version > CurrentVersion {
-		return fmt.Errorf(
-			"%w: config version %d is newer than supported version %d (upgrade agentwatch)",
-			ErrInvalid, cfg.Version, CurrentVersion,
-		)
-	}
-	if cfg.Version < 1 {
-		return fmt.Errorf("%w: config version %d is invalid", ErrInvalid, cfg.Version)
+	if err := checkMigratable(cfg.Version); err != nil {
+		return err
 	}
 
 	// Apply migrations sequentially: v1→v2, v2→v3, etc.
@@ -37,14 +67,17 @@ func migrate(cfg *Config) error {
 // migrations maps each version to the function that migrates it to the next version.
 // The migration function must increment cfg.Version after a successful migration.
 var migrations = map[int]func(*Config) error{
-	1: migrateV1ToV2,
-	2: migrateV2ToV3,
-	3: migrateV3ToV4,
-	4: migrateV4ToV5,
-	5: migrateV5ToV6,
-	6: migrateV6ToV7,
-	7: migrateV7ToV8,
-	8: migrateV8ToV9,
+	1:  migrateV1ToV2,
+	2:  migrateV2ToV3,
+	3:  migrateV3ToV4,
+	4:  migrateV4ToV5,
+	5:  migrateV5ToV6,
+	6:  migrateV6ToV7,
+	7:  migrateV7ToV8,
+	8:  migrateV8ToV9,
+	9:  migrateV9ToV10,
+	10: migrateV10ToV11,
+	11: migrateV11ToV12,
 }
 
 // migrateV1ToV2 adds the wip_limits field (defaults to nil/empty = unlimited).
@@ -134,3 +167,51 @@ func migrateV8ToV9(cfg *Config) error { //nolint:unparam // signature must match
 	cfg.Version = 9
 	return nil
 }
+
+// migrateV9ToV10 adds the terminal marker to statuses, stamping it onto the
+// status that was previously inferred as done by position.
+func migrateV9ToV10(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	done := inferredDoneStatus(cfg.StatusNames())
+	for i := range cfg.Statuses {
+		if cfg.Statuses[i].Name == done && done != ArchivedStatus {
+			cfg.Statuses[i].Terminal = true
+		}
+	}
+	cfg.Version = 10
+	return nil
+}
+
+// migrateV10ToV11 adds colors to priorities, giving the default priority
+// names the colors they were previously hard-coded to render with.
+func migrateV10ToV11(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	for i, p := range cfg.Priorities {
+		if p.Color != "" {
+			continue
+		}
+		for _, d := range DefaultPriorities {
+			if d.Name == p.Name {
+				cfg.Priorities[i].Color = d.Color
+			}
+		}
+	}
+	cfg.Version = 11
+	return nil
+}
+
+// migrateV11ToV12 adds the require_due and escalate_before policies to the
+// default fixed-date class, unless the board already set either of them.
+func migrateV11ToV12(cfg *Config) error { //nolint:unparam // signature must match migrations map type
+	for i, cl := range cfg.Classes {
+		if cl.RequireDue || cl.EscalateBefore != "" {
+			continue
+		}
+		for _, d := range DefaultClasses {
+			if d.Name == cl.Name {
+				cfg.Classes[i].RequireDue = d.RequireDue
+				cfg.Classes[i].EscalateBefore = d.EscalateBefore
+			}
+		}
+	}
+	cfg.Version = 12
+	return nil
+}
@@ -0,0 +1,41 @@
+package config
+
+import "testing"
+
+func TestMigrateV11ToV12AddsClassPolicies(t *testing.T) {
+	cfg := &Config{
+		Version: 11,
+		Classes: []ClassConfig{
+			{Name: "expedite", WIPLimit: 1, BypassColumnWIP: true},
+			{Name: "fixed-date"},
+			{Name: "standard"},
+			{Name: "custom"},
+		},
+	}
+	if err := migrate(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	fixed := cfg.ClassByName("fixed-date")
+	if !fixed.RequireDue || fixed.EscalateBefore != "3d" {
+		t.Errorf("fixed-date = %+v, want require_due and escalate_before 3d", *fixed)
+	}
+	for _, name := range []string{"expedite", "standard", "custom"} {
+		if cl := cfg.ClassByName(name); cl.RequireDue || cl.EscalateBefore != "" {
+			t.Errorf("%s = %+v, want no due policy", name, *cl)
+		}
+	}
+}
+
+func TestMigrateV11ToV12KeepsCustomizedPolicy(t *testing.T) {
+	cfg := &Config{Version: 11, Classes: []ClassConfig{{Name: "fixed-date", EscalateBefore: "1w"}}}
+	if err := migrate(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cl := cfg.ClassByName("fixed-date"); cl.RequireDue || cl.EscalateBefore != "1w" {
+		t.Errorf("fixed-date = %+v, want the board's own escalate_before kept", *cl)
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseSortSpec(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    []SortKey
+		wantErr bool
+	}{
+		{"id", []SortKey{{Field: "id"}}, false},
+		{"-created", []SortKey{{Field: "created", Desc: true}}, false},
+		{"priority, due ,-updated", []SortKey{{Field: "priority"}, {Field: "due"}, {Field: "updated", Desc: true}}, false},
+		{"", nil, true},
+		{"title", nil, true},
+		{"priority,,due", nil, true},
+		{"--id", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseSortSpec(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSortSpec(%q) error = %v, want error %v", tt.spec, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseSortSpec(%q) = %+v, want %+v", tt.spec, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateChecksListSortWithParseSortSpec(t *testing.T) {
+	cfg := NewDefault("test")
+	cfg.Defaults.List.Sort = "priority,-bogus"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Validate accepted an invalid defaults.list.sort")
+	}
+	cfg.Defaults.List.Sort = "priority,-due"
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Validate rejected a valid defaults.list.sort: %v", err)
+	}
+}
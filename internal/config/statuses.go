@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AddStatus inserts a status after the named one, or before the archived
+// status (or at the end) when after is empty. A positive wipLimit sets the
+// new column's WIP limit.
+func (c *Config) AddStatus(s StatusConfig, after string, wipLimit int) error {
+	if s.Name == "" {
+		return fmt.Errorf("%w: status name is required", ErrInvalid)
+	}
+	if s.Name == ArchivedStatus {
+		return fmt.Errorf("%w: %q is reserved", ErrInvalid, ArchivedStatus)
+	}
+	if c.StatusIndex(s.Name) >= 0 {
+		return fmt.Errorf("%w: status %q already exists", ErrInvalid, s.Name)
+	}
+
+	pos := c.archivedBoundary()
+	if after != "" {
+		i := c.StatusIndex(after)
+		if i < 0 {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalid, after)
+		}
+		if after == ArchivedStatus {
+			return fmt.Errorf("%w: %q must remain the last status", ErrInvalid, ArchivedStatus)
+		}
+		pos = i + 1
+	}
+	c.Statuses = slices.Insert(c.Statuses, pos, s)
+
+	if wipLimit > 0 {
+		if c.WIPLimits == nil {
+			c.WIPLimits = make(map[string]int)
+		}
+		c.WIPLimits[s.Name] = wipLimit
+	}
+	return nil
+}
+
+// RemoveStatus deletes a status and its WIP limit. The archived status, the
+// default status and the last two statuses cannot be removed.
+func (c *Config) RemoveStatus(name string) error {
+	i, err := c.mutableStatus(name)
+	if err != nil {
+		return err
+	}
+	if len(c.Statuses) <= 2 { //nolint:mnd // minimum 2 statuses for a kanban board
+		return fmt.Errorf("%w: at least 2 statuses are required", ErrInvalid)
+	}
+	if c.Defaults.Status == name {
+		return fmt.Errorf("%w: %q is the default status; change defaults.status first", ErrInvalid, name)
+	}
+	c.Statuses = slices.Delete(c.Statuses, i, i+1)
+	delete(c.WIPLimits, name)
+	delete(c.WIPMinimums, name)
+	c.Defaults.List.ExcludeStatuses = slices.DeleteFunc(c.Defaults.List.ExcludeStatuses,
+		func(s string) bool { return s == name })
+	return nil
+}
+
+// RenameStatus renames a status, carrying over its WIP limit, minimum, list
+// exclusion and default.
+func (c *Config) RenameStatus(oldName, newName string) error {
+	i, err := c.mutableStatus(oldName)
+	if err != nil {
+		return err
+	}
+	if newName == "" {
+		return fmt.Errorf("%w: status name is required", ErrInvalid)
+	}
+	if newName == ArchivedStatus {
+		return fmt.Errorf("%w: %q is reserved", ErrInvalid, ArchivedStatus)
+	}
+	if c.StatusIndex(newName) >= 0 {
+		return fmt.Errorf("%w: status %q already exists", ErrInvalid, newName)
+	}
+
+	c.Statuses[i].Name = newName
+	renameKey(c.WIPLimits, oldName, newName)
+	renameKey(c.WIPMinimums, oldName, newName)
+	if j := slices.Index(c.Defaults.List.ExcludeStatuses, oldName); j >= 0 {
+		c.Defaults.List.ExcludeStatuses[j] = newName
+	}
+	if c.Defaults.Status == oldName {
+		c.Defaults.Status = newName
+	}
+	return nil
+}
+
+// renameKey moves m[oldKey] to m[newKey] when present.
+func renameKey(m map[string]int, oldKey, newKey string) {
+	if v, ok := m[oldKey]; ok {
+		delete(m, oldKey)
+		m[newKey] = v
+	}
+}
+
+// MoveStatus moves a status to a 1-based position. The archived status stays
+// last, so positions at or after it are rejected.
+func (c *Config) MoveStatus(name string, position int) error {
+	i, err := c.mutableStatus(name)
+	if err != nil {
+		return err
+	}
+	limit := c.archivedBoundary()
+	if position < 1 || position > limit {
+		return fmt.Errorf("%w: position must be between 1 and %d", ErrInvalid, limit)
+	}
+
+	s := c.Statuses[i]
+	c.Statuses = slices.Delete(c.Statuses, i, i+1)
+	c.Statuses = slices.Insert(c.Statuses, position-1, s)
+	return nil
+}
+
+// mutableStatus returns the index of a status that may be changed, rejecting
+// unknown names and the archived status.
+func (c *Config) mutableStatus(name string) (int, error) {
+	i := c.StatusIndex(name)
+	if i < 0 {
+		return -1, fmt.Errorf("%w: unknown status %q", ErrInvalid, name)
+	}
+	if name == ArchivedStatus {
+		return -1, fmt.Errorf("%w: the %q status cannot be changed", ErrInvalid, ArchivedStatus)
+	}
+	return i, nil
+}
+
+// archivedBoundary returns the index of the archived status, or the number
+// of statuses when the board has none.
+func (c *Config) archivedBoundary() int {
+	if i := c.StatusIndex(ArchivedStatus); i >= 0 {
+		return i
+	}
+	return len(c.Statuses)
+}
+
+// ResolveStatus maps user input to a canonical status name. An exact match
+// wins; otherwise names and aliases match case-insensitively, ignoring
+// spaces, hyphens and underscores, so "In-Progress", "in progress" and
+// "inprogress" all resolve to "in-progress". Input matching more than one
+// status is not resolved.
+func (c *Config) ResolveStatus(input string) (string, bool) {
+	if c.StatusIndex(input) >= 0 {
+		return input, true
+	}
+	key := normalizeStatus(input)
+	if key == "" {
+		return "", false
+	}
+	match := ""
+	for _, s := range c.Statuses {
+		if normalizeStatus(s.Name) != key && !slices.ContainsFunc(s.Aliases, func(a string) bool {
+			return normalizeStatus(a) == key
+		}) {
+			continue
+		}
+		if match != "" {
+			return "", false
+		}
+		match = s.Name
+	}
+	return match, match != ""
+}
+
+// normalizeStatus folds case and drops spaces, hyphens and underscores.
+func normalizeStatus(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
+
+// validateStatusAliases rejects empty aliases and aliases that would match
+// more than one status.
+func (c *Config) validateStatusAliases() error {
+	owner := make(map[string]string)
+	for _, s := range c.Statuses {
+		owner[normalizeStatus(s.Name)] = s.Name
+	}
+	for _, s := range c.Statuses {
+		for _, a := range s.Aliases {
+			key := normalizeStatus(a)
+			if key == "" {
+				return fmt.Errorf("%w: status %q has an empty alias", ErrInvalid, s.Name)
+			}
+			if other, ok := owner[key]; ok && other != s.Name {
+				return fmt.Errorf("%w: alias %q of status %q also matches status %q", ErrInvalid, a, s.Name, other)
+			}
+			owner[key] = s.Name
+		}
+	}
+	return nil
+}
@@ -3,7 +3,10 @@ package date
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.yaml.in/yaml/v3"
@@ -74,3 +77,51 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	*d = parsed
 	return nil
 }
+
+// Day and week lengths used by ParseDuration.
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// ParseDuration parses a duration string. In addition to the units accepted by
+// time.ParseDuration it understands a single "d" (days) or "w" (weeks) suffix,
+// e.g. "30d" or "2w".
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, errors.New("empty duration")
+	}
+	unit := time.Duration(0)
+	switch s[len(s)-1] {
+	case 'd':
+		unit = day
+	case 'w':
+		unit = week
+	}
+	if unit == 0 {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: expected e.g. 90m, 24h, 7d, 2w", s)
+		}
+		return d, nil
+	}
+	n, err := strconv.ParseFloat(s[:len(s)-1], 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid duration %q: expected e.g. 90m, 24h, 7d, 2w", s)
+	}
+	return time.Duration(n * float64(unit)), nil
+}
+
+// ParseSince resolves s to a point in time: either a YYYY-MM-DD date or a
+// duration (see ParseDuration) counted back from now.
+func ParseSince(s string, now time.Time) (time.Time, error) {
+	if d, err := Parse(s); err == nil {
+		return d.Time, nil
+	}
+	dur, err := ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected YYYY-MM-DD or a duration like 24h, 7d", s)
+	}
+	return now.Add(-dur), nil
+}
@@ -2,9 +2,55 @@
 // concurrent access to shared resources (e.g., config files).
 package filelock
 
-import "os"
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
 
-const lockFileMode = 0o600
+const (
+	lockFileMode = 0o600
+	pollInterval = 10 * time.Millisecond
+)
+
+// ErrLockTimeout is matched by the *TimeoutError LockWithTimeout returns
+// when the lock could not be acquired in time.
+var ErrLockTimeout = errors.New("timed out waiting for lock")
+
+// Holder is the process recorded in a lock file by its last acquirer.
+type Holder struct {
+	PID      int
+	Acquired time.Time
+	Alive    bool // whether PID still names a running process
+}
+
+// TimeoutError reports a lock that could not be acquired in time and who
+// appears to hold it.
+type TimeoutError struct {
+	Path    string
+	Timeout time.Duration
+	Holder  *Holder // nil when the lock file records no readable holder
+}
+
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timed out after %s waiting for lock %s", e.Timeout, e.Path)
+	if h := e.Holder; h != nil {
+		msg += fmt.Sprintf(" (held by pid %d since %s", h.PID, h.Acquired.Local().Format(time.DateTime))
+		if !h.Alive {
+			msg += "; that process is no longer running"
+		}
+		msg += ")"
+	}
+	return msg
+}
+
+// Unwrap makes errors.Is(err, ErrLockTimeout) match.
+func (e *TimeoutError) Unwrap() error {
+	return ErrLockTimeout
+}
 
 // Lock acquires an exclusive advisory lock on the file at path,
 // creating it if it does not exist. The returned function releases
@@ -13,7 +59,7 @@ const lockFileMode = 0o600
 // Only one process can hold the lock at a time; other callers block
 // until the lock is available.
 func Lock(path string) (unlock func() error, err error) {
-	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, lockFileMode) //nolint:gosec // lock file path from trusted source
+	f, err := openLockFile(path)
 	if err != nil {
 		return nil, err
 	}
@@ -22,6 +68,68 @@ func Lock(path string) (unlock func() error, err error) {
 		_ = f.Close()
 		return nil, err
 	}
+	return acquired(f), nil
+}
+
+// LockWithTimeout is like Lock but gives up after timeout, returning a
+// *TimeoutError that names the process recorded as holding the lock.
+func LockWithTimeout(path string, timeout time.Duration) (unlock func() error, err error) {
+	f, err := openLockFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, err := tryLockFile(f)
+		if err != nil {
+			_ = f.Close()
+			return nil, err
+		}
+		if ok {
+			return acquired(f), nil
+		}
+		if time.Now().After(deadline) {
+			_ = f.Close()
+			return nil, &TimeoutError{Path: path, Timeout: timeout, Holder: ReadHolder(path)}
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// ReadHolder returns the holder recorded in the lock file at path, or nil
+// when none can be read.
+func ReadHolder(path string) *Holder {
+	data, err := os.ReadFile(path) //nolint:gosec // lock file path from trusted source
+	if err != nil {
+		return nil
+	}
+	pidStr, ts, ok := strings.Cut(strings.TrimSpace(string(data)), " ")
+	if !ok {
+		return nil
+	}
+	pid, err := strconv.Atoi(pidStr)
+	if err != nil || pid <= 0 {
+		return nil
+	}
+	at, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return nil
+	}
+	return &Holder{PID: pid, Acquired: at, Alive: processAlive(pid)}
+}
+
+func openLockFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_CREATE|os.O_RDWR, lockFileMode) //nolint:gosec // lock file path from trusted source
+}
+
+// acquired records this process as the holder of the locked file f and
+// returns its unlock function.
+func acquired(f *os.File) func() error {
+	// Best-effort: the holder is only diagnostic.
+	if err := f.Truncate(0); err == nil {
+		_, _ = fmt.Fprintf(f, "%d %s\n", os.Getpid(), time.Now().UTC().Format(time.RFC3339))
+	}
 
 	return func() error {
 		unlockErr := unlockFile(f)
@@ -30,5 +138,5 @@ func Lock(path string) (unlock func() error, err error) {
 			return unlockErr
 		}
 		return closeErr
-	}, nil
+	}
 }
@@ -14,40 +14,68 @@ const (
 	lockfileExclusiveLock   = 0x00000002
 	lockfileFailImmediately = 0x00000001
 	lockRetryInterval       = time.Millisecond
+	stillActive             = 259 // STILL_ACTIVE exit code of a running process
 )
 
+// lockRange returns the Overlapped for the locked byte. It sits far past the
+// end of the file rather than at offset 0, because byte-range locks are
+// mandatory on Windows and the holder's PID is written at the start of the
+// file, where other processes must be able to read it.
+func lockRange() *windows.Overlapped {
+	return &windows.Overlapped{OffsetHigh: 0xFFFFFFFF}
+}
+
 func lockFile(f *os.File) error {
-	ol := new(windows.Overlapped)
 	for {
-		err := windows.LockFileEx(
-			windows.Handle(f.Fd()),
-			lockfileExclusiveLock|lockfileFailImmediately,
-			0, // reserved
-			1, // lock 1 byte
-			0, // high word
-			ol,
-		)
-		if err == nil {
-			return nil
+		ok, err := tryLockFile(f)
+		if err != nil || ok {
+			return err
 		}
-		// ERROR_LOCK_VIOLATION means another handle holds the lock.
 		// Sleep briefly to yield to the Go scheduler and retry.
 		// Without LOCKFILE_FAIL_IMMEDIATELY, LockFileEx blocks the OS thread,
 		// which can starve goroutines and cause deadlocks.
-		if !errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
-			return err
-		}
 		time.Sleep(lockRetryInterval)
 	}
 }
 
+// tryLockFile attempts the lock without blocking; it reports false when
+// another handle holds it.
+func tryLockFile(f *os.File) (bool, error) {
+	err := windows.LockFileEx(
+		windows.Handle(f.Fd()),
+		lockfileExclusiveLock|lockfileFailImmediately,
+		0, // reserved
+		1, // lock 1 byte
+		0, // high word
+		lockRange(),
+	)
+	// ERROR_LOCK_VIOLATION means another handle holds the lock.
+	if errors.Is(err, windows.ERROR_LOCK_VIOLATION) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func unlockFile(f *os.File) error {
-	ol := new(windows.Overlapped)
 	return windows.UnlockFileEx(
 		windows.Handle(f.Fd()),
 		0, // reserved
 		1, // unlock 1 byte
 		0, // high word
-		ol,
+		lockRange(),
 	)
 }
+
+func processAlive(pid int) bool {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid)) //nolint:gosec // pid read from lock file
+	if err != nil {
+		// Access denied means the process exists but belongs to someone else.
+		return errors.Is(err, windows.ERROR_ACCESS_DENIED)
+	}
+	defer windows.CloseHandle(h) //nolint:errcheck // best-effort close
+	var code uint32
+	if err := windows.GetExitCodeProcess(h, &code); err != nil {
+		return true
+	}
+	return code == stillActive
+}
@@ -0,0 +1,52 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+)
+
+// ClaimTable renders active claims with the time left on each.
+func ClaimTable(w io.Writer, claims []board.Claim, now time.Time) {
+	if len(claims) == 0 {
+		fmt.Fprintln(os.Stderr, "No claimed tasks.")
+		return
+	}
+	const idW, statusW, sinceW, leftW = 5, 18, 18, 10
+	header := fmt.Sprintf("%-*s %-*s %-*s %-*s %s", idW, "ID", statusW, "STATUS", sinceW, "CLAIMED", leftW, "EXPIRES", "TITLE")
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, c := range claims {
+		fmt.Fprintf(w, "%s %s %s %s %s\n",
+			padRight(strconv.Itoa(c.ID), idW),
+			padRight(styledValue(c.Status, statusStyles), statusW),
+			padRight(claimSince(c), sinceW),
+			padRight(claimLeft(c, now), leftW),
+			c.Title)
+	}
+}
+
+// ClaimCompact renders one line per active claim.
+func ClaimCompact(w io.Writer, claims []board.Claim, now time.Time) {
+	for _, c := range claims {
+		fmt.Fprintf(w, "#%d [%s] %s expires:%s\n", c.ID, c.Status, c.Title, claimLeft(c, now))
+	}
+}
+
+func claimSince(c board.Claim) string {
+	if c.ClaimedAt == nil {
+		return "--"
+	}
+	return c.ClaimedAt.Format("2006-01-02 15:04")
+}
+
+func claimLeft(c board.Claim, now time.Time) string {
+	d, ok := c.Remaining(now)
+	if !ok {
+		return "never"
+	}
+	return "in " + HumanDuration(d)
+}
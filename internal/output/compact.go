@@ -44,7 +44,7 @@ func TaskDetailCompact(w io.Writer, t *task.Task) {
 
 	if t.Body != "" {
 		for _, bodyLine := range strings.Split(t.Body, "\n") {
-			fmt.Fprintln(w, "  "+bodyLine)
+			fmt.Fprintln(w, "  "+truncateLine(bodyLine))
 		}
 	}
 }
@@ -54,23 +54,96 @@ func OverviewCompact(w io.Writer, s board.Overview) {
 	fmt.Fprintf(w, "%s (%d tasks)\n", s.BoardName, s.TotalTasks)
 
 	for _, ss := range s.Statuses {
-		line := "  " + ss.Status + ": " + strconv.Itoa(ss.Count)
-		if ss.WIPLimit > 0 {
-			line += "/" + strconv.Itoa(ss.WIPLimit)
-		}
-		var annotations []string
-		if ss.Blocked > 0 {
-			annotations = append(annotations, strconv.Itoa(ss.Blocked)+" blocked")
-		}
-		if ss.Overdue > 0 {
-			annotations = append(annotations, strconv.Itoa(ss.Overdue)+" overdue")
+		fmt.Fprintln(w, "  "+compactStatusCount(ss)+compactStatusAnnotations(ss))
+	}
+
+	overviewCompactPriorities(w, s)
+}
+
+// AssigneeOverviewCompact renders OverviewCompact once per assignee.
+func AssigneeOverviewCompact(w io.Writer, overviews []board.AssigneeOverview) {
+	for _, ao := range overviews {
+		ov := ao.Overview
+		ov.BoardName = ao.Assignee
+		OverviewCompact(w, ov)
+	}
+}
+
+// maxBarWidth is the width of the bar for the largest status in
+// OverviewCompactPercent.
+const maxBarWidth = 20
+
+// OverviewCompactPercent renders a board summary in compact format with each
+// status's share of the total and a bar scaled to the largest status.
+func OverviewCompactPercent(w io.Writer, s board.Overview) {
+	fmt.Fprintf(w, "%s (%d tasks)\n", s.BoardName, s.TotalTasks)
+
+	largest, labelW := 0, 0
+	labels := make([]string, len(s.Statuses))
+	for i, ss := range s.Statuses {
+		pct := 0
+		if s.TotalTasks > 0 {
+			pct = ss.Count * 100 / s.TotalTasks //nolint:mnd // percent
 		}
-		if len(annotations) > 0 {
-			line += " (" + strings.Join(annotations, ", ") + ")"
+		labels[i] = compactStatusCount(ss) + " (" + strconv.Itoa(pct) + "%)"
+		largest = max(largest, ss.Count)
+		labelW = max(labelW, len(labels[i]))
+	}
+
+	for i, ss := range s.Statuses {
+		bar := ""
+		if largest > 0 {
+			n := ss.Count * maxBarWidth / largest
+			if n == 0 && ss.Count > 0 {
+				n = 1
+			}
+			bar = strings.Repeat("█", n)
 		}
-		fmt.Fprintln(w, line)
+		line := "  " + padRight(labels[i], labelW) + " " + padRight(bar, maxBarWidth)
+		fmt.Fprintln(w, strings.TrimRight(line+compactStatusAnnotations(ss), " "))
+	}
+
+	overviewCompactPriorities(w, s)
+}
+
+// compactStatusCount formats "status: count[/limit][!]".
+func compactStatusCount(ss board.StatusSummary) string {
+	line := ss.Status + ": " + strconv.Itoa(ss.Count)
+	if ss.WIPLimit > 0 {
+		line += "/" + strconv.Itoa(ss.WIPLimit)
+	}
+	if ss.OverWIP {
+		line += "!"
+	}
+	return line
+}
+
+// compactStatusAnnotations formats " (N blocked, M overdue, R at risk,
+// below minimum K, avg A, oldest O)", or "".
+func compactStatusAnnotations(ss board.StatusSummary) string {
+	var annotations []string
+	if ss.Blocked > 0 {
+		annotations = append(annotations, strconv.Itoa(ss.Blocked)+" blocked")
+	}
+	if ss.Overdue > 0 {
+		annotations = append(annotations, strconv.Itoa(ss.Overdue)+" overdue")
 	}
+	if ss.AtRisk > 0 {
+		annotations = append(annotations, strconv.Itoa(ss.AtRisk)+" at risk")
+	}
+	if ss.UnderMin {
+		annotations = append(annotations, "below minimum "+strconv.Itoa(ss.WIPMinimum))
+	}
+	if ss.Count > 0 && ss.OldestAge > 0 {
+		annotations = append(annotations, "avg "+HumanDuration(ss.AvgAge)+", oldest "+HumanDuration(ss.OldestAge))
+	}
+	if len(annotations) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(annotations, ", ") + ")"
+}
 
+func overviewCompactPriorities(w io.Writer, s board.Overview) {
 	if len(s.Priorities) > 0 {
 		parts := make([]string, 0, len(s.Priorities))
 		for _, pc := range s.Priorities {
@@ -80,7 +153,6 @@ func OverviewCompact(w io.Writer, s board.Overview) {
 	}
 }
 
-
 // formatTaskLine builds the one-line representation of a task.
 func formatTaskLine(t *task.Task) string {
 	line := "#" + strconv.Itoa(t.ID) + " [" + t.Status + "/" + t.Priority + "] " + t.Title
@@ -94,7 +166,9 @@ func formatTaskLine(t *task.Task) string {
 	if t.Due != nil {
 		line += " due:" + t.Due.String()
 	}
+	if len(t.Links) > 0 {
+		line += " links:" + strconv.Itoa(len(t.Links))
+	}
 
 	return line
 }
-
@@ -0,0 +1,65 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// csvHeader lists the columns written by TaskCSV.
+var csvHeader = []string{
+	"id", "title", "status", "priority", "assignee", "tags", "class", "due",
+	"created", "updated", "started", "completed", "claimed_by", "blocked", "estimate",
+}
+
+// TaskCSV writes tasks as RFC 4180 CSV with a header row. Tags are joined
+// with ";" and timestamps use RFC 3339.
+func TaskCSV(w io.Writer, tasks []*task.Task) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	for _, t := range tasks {
+		due := ""
+		if t.Due != nil {
+			due = t.Due.String()
+		}
+		record := []string{
+			strconv.Itoa(t.ID),
+			t.Title,
+			t.Status,
+			t.Priority,
+			t.Assignee,
+			strings.Join(t.Tags, ";"),
+			t.Class,
+			due,
+			t.Created.Format(time.RFC3339),
+			t.Updated.Format(time.RFC3339),
+			formatOptionalTime(t.Started),
+			formatOptionalTime(t.Completed),
+			t.ClaimedBy,
+			strconv.FormatBool(t.Blocked),
+			t.Estimate,
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("writing CSV: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,55 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+var (
+	diffAddStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("34"))
+	diffDelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+)
+
+// DiffTable renders field changes between two tasks as a table, followed by
+// a unified diff of their bodies.
+func DiffTable(w io.Writer, labelA, labelB string, changes []task.FieldChange, bodyDiff []string) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No differences.")
+		return
+	}
+
+	const pad = 2
+	fieldW, oldW := len("FIELD")+pad, len(labelA)+pad
+	for _, c := range changes {
+		fieldW = max(fieldW, len(c.Field)+pad)
+		oldW = max(oldW, len(c.Old)+pad)
+	}
+	header := padRight("FIELD", fieldW) + " " + padRight(labelA, oldW) + " " + labelB
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, c := range changes {
+		fmt.Fprintf(w, "%s %s %s\n", padRight(c.Field, fieldW), padRight(stringOrDash(c.Old), oldW), stringOrDash(c.New))
+	}
+
+	if len(bodyDiff) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, dimStyle.Render("--- "+labelA))
+	fmt.Fprintln(w, dimStyle.Render("+++ "+labelB))
+	for _, line := range bodyDiff {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			line = dimStyle.Render(line)
+		case strings.HasPrefix(line, "+"):
+			line = diffAddStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			line = diffDelStyle.Render(line)
+		}
+		fmt.Fprintln(w, line)
+	}
+}
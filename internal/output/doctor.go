@@ -0,0 +1,42 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+)
+
+// DoctorTable renders doctor problems with their repair state.
+func DoctorTable(w io.Writer, problems []board.Problem) {
+	const checkW, taskW, stateW = 15, 6, 9
+	header := fmt.Sprintf("%-*s %-*s %-*s %s", checkW, "CHECK", taskW, "TASK", stateW, "STATE", "PROBLEM")
+	fmt.Fprintln(w, headerStyle.Render(header))
+
+	for _, p := range problems {
+		id := dimStyle.Render("--")
+		if p.TaskID > 0 {
+			id = "#" + strconv.Itoa(p.TaskID)
+		}
+		var state string
+		switch {
+		case p.Fixed:
+			state = claimStyle.Render("fixed")
+		case p.Error != "":
+			state = warnStyle.Render("failed")
+		case p.Fixable:
+			state = "fixable"
+		default:
+			state = warnStyle.Render("manual")
+		}
+		msg := p.Message
+		if p.File != "" {
+			msg = p.File + ": " + msg
+		}
+		if p.Error != "" {
+			msg += " (" + p.Error + ")"
+		}
+		fmt.Fprintf(w, "%s %s %s %s\n", padRight(p.Check, checkW), padRight(id, taskW), padRight(state, stateW), msg)
+	}
+}
@@ -0,0 +1,154 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
+)
+
+// taskColumn describes one selectable column of task list output.
+type taskColumn struct {
+	header string
+	minW   int                             // minimum column width, including padding
+	maxW   int                             // maximum column width (0 = unbounded)
+	value  func(*task.Task) string         // plain cell text; "" renders as "--"
+	style  func(string, *task.Task) string // optional styling for non-empty cells
+}
+
+// DefaultTaskFields are the columns shown when no field selection is given.
+var DefaultTaskFields = []string{"id", "status", "priority", "title", "claimed", "tags", "due"}
+
+// taskFieldOrder lists every selectable field in display order for help text.
+var taskFieldOrder = []string{
+	"id", "status", "priority", "title", "assignee", "claimed", "tags",
+	"due", "class", "created", "updated", "age", "blocked",
+}
+
+// TaskFields returns the field names accepted by TaskTableFields.
+func TaskFields() []string {
+	return taskFieldOrder
+}
+
+const maxTitleLen = 48
+
+var taskColumns = map[string]taskColumn{
+	"id": {header: "ID", minW: 4, value: func(t *task.Task) string { return strconv.Itoa(t.ID) }},
+	"status": {header: "STATUS", minW: 8, value: func(t *task.Task) string { return t.Status },
+		style: func(s string, _ *task.Task) string { return styledValue(s, statusStyles) }},
+	"priority": {header: "PRIORITY", minW: 10, value: func(t *task.Task) string { return t.Priority },
+		style: func(s string, _ *task.Task) string { return styledValue(s, priorityStyles) }},
+	"title": {header: "TITLE", minW: 5, maxW: 50, value: func(t *task.Task) string { //nolint:mnd // max title column width
+		if len(t.Title) > maxTitleLen {
+			return t.Title[:maxTitleLen-3] + "..."
+		}
+		return t.Title
+	}},
+	"assignee": {header: "ASSIGNEE", minW: 10, value: func(t *task.Task) string { return t.Assignee }},
+	"claimed": {header: "CLAIMED", minW: 9, value: claimDisplay,
+		style: func(s string, _ *task.Task) string { return claimStyle.Render(s) }},
+	"tags": {header: "TAGS", minW: 6, maxW: 30, value: func(t *task.Task) string { //nolint:mnd // max tags column width
+		return strings.Join(t.Tags, ",")
+	}, style: func(s string, _ *task.Task) string { return tagStyle.Render(s) }},
+	"due": {header: "DUE", minW: 12, value: func(t *task.Task) string {
+		if t.Due == nil {
+			return ""
+		}
+		return t.Due.String()
+	}},
+	"class": {header: "CLASS", minW: 7, value: func(t *task.Task) string { return t.Class }},
+	"created": {header: "CREATED", minW: 12, value: func(t *task.Task) string {
+		return t.Created.Format("2006-01-02")
+	}},
+	"updated": {header: "UPDATED", minW: 12, value: func(t *task.Task) string {
+		return t.Updated.Format("2006-01-02")
+	}},
+	"age": {header: "AGE", minW: 5, value: func(t *task.Task) string {
+		return HumanDuration(time.Since(t.Updated))
+	}},
+	"blocked": {header: "BLOCKED", minW: 9, value: func(t *task.Task) string {
+		if t.Blocked {
+			return "yes"
+		}
+		return ""
+	}},
+}
+
+// TaskTableFields renders tasks as a table with only the given columns, in
+// order. Field names must be valid (see TaskFields).
+func TaskTableFields(w io.Writer, tasks []*task.Task, fields []string) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, "No tasks found.")
+		return
+	}
+
+	const pad = 2
+	cols := make([]taskColumn, len(fields))
+	widths := make([]int, len(fields))
+	for i, f := range fields {
+		cols[i] = taskColumns[f]
+		widths[i] = cols[i].minW
+		for _, t := range tasks {
+			n := len(cols[i].value(t)) + pad
+			if cols[i].maxW > 0 {
+				n = min(n, cols[i].maxW)
+			}
+			widths[i] = max(widths[i], n)
+		}
+	}
+
+	cells := make([]string, len(cols))
+	for i, c := range cols {
+		cells[i] = padRight(c.header, widths[i])
+	}
+	fmt.Fprintln(w, headerStyle.Render(strings.TrimRight(strings.Join(cells, " "), " ")))
+
+	for _, t := range tasks {
+		for i, c := range cols {
+			cells[i] = padRight(renderTaskCell(c, t), widths[i])
+		}
+		fmt.Fprintln(w, strings.TrimRight(strings.Join(cells, " "), " "))
+	}
+}
+
+func renderTaskCell(c taskColumn, t *task.Task) string {
+	v := c.value(t)
+	switch {
+	case v == "":
+		return dimStyle.Render("--")
+	case c.style != nil:
+		return c.style(v, t)
+	default:
+		return v
+	}
+}
+
+// TaskCompactFields renders one line per task with only the given fields.
+// ID and title are shown bare; other fields as name:value, omitting empty ones.
+func TaskCompactFields(w io.Writer, tasks []*task.Task, fields []string) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(os.Stderr, "No tasks found.")
+		return
+	}
+
+	for _, t := range tasks {
+		parts := make([]string, 0, len(fields))
+		for _, f := range fields {
+			v := taskColumns[f].value(t)
+			switch {
+			case v == "":
+			case f == "id":
+				parts = append(parts, "#"+v)
+			case f == "title", f == "claimed":
+				parts = append(parts, v)
+			default:
+				parts = append(parts, f+":"+v)
+			}
+		}
+		fmt.Fprintln(w, strings.Join(parts, " "))
+	}
+}
@@ -4,10 +4,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
 )
 
-// JSON writes data as indented JSON to the given writer.
+// compactJSON makes JSON write single-line output (see SetCompactJSON).
+var compactJSON bool
+
+// SetCompactJSON makes JSON and JSONError encode without indentation, as
+// selected by --json-compact.
+func SetCompactJSON(on bool) {
+	compactJSON = on
+}
+
+// JSON writes data as indented JSON to the given writer, or on a single line
+// when compact JSON is enabled.
 func JSON(w io.Writer, data interface{}) error {
+	if compactJSON {
+		return JSONCompact(w, data)
+	}
 	enc := json.NewEncoder(w)
 	enc.SetIndent("", "  ")
 	if err := enc.Encode(data); err != nil {
@@ -16,6 +31,26 @@ func JSON(w io.Writer, data interface{}) error {
 	return nil
 }
 
+// JSONCompact writes data as single-line JSON to the given writer.
+func JSONCompact(w io.Writer, data interface{}) error {
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		return fmt.Errorf("encoding JSON: %w", err)
+	}
+	return nil
+}
+
+// TaskJSONL writes one compact JSON object per task and line, encoding each
+// task as it goes rather than building the whole array in memory.
+func TaskJSONL(w io.Writer, tasks []*task.Task) error {
+	enc := json.NewEncoder(w)
+	for _, t := range tasks {
+		if err := enc.Encode(t); err != nil {
+			return fmt.Errorf("encoding JSON: %w", err)
+		}
+	}
+	return nil
+}
+
 // ErrorResponse is the JSON envelope for structured error output.
 type ErrorResponse struct {
 	Error   string         `json:"error"`
@@ -27,7 +62,9 @@ type ErrorResponse struct {
 func JSONError(w io.Writer, code, msg string, details map[string]any) {
 	resp := ErrorResponse{Error: msg, Code: code, Details: details}
 	enc := json.NewEncoder(w)
-	enc.SetIndent("", "  ")
+	if !compactJSON {
+		enc.SetIndent("", "  ")
+	}
 	_ = enc.Encode(resp) // best-effort; if writer fails, nothing we can do
 }
 
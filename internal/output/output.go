@@ -17,31 +17,82 @@ const (
 	FormatTable
 	// FormatCompact outputs one-line-per-record compact format.
 	FormatCompact
+	// FormatCSV outputs RFC 4180 CSV (task lists only; other views fall back to table).
+	FormatCSV
+	// FormatJSONL streams one compact JSON object per line (task lists only;
+	// other views fall back to table).
+	FormatJSONL
 )
 
 // Detect returns the appropriate format based on flags and environment.
-// Default is table when no explicit format is set.
-func Detect(jsonFlag, tableFlag, compactFlag bool) Format {
+// formatFlag is the value of --format (empty when unset); the boolean flags
+// take precedence over it. Default is table when no explicit format is set.
+func Detect(jsonFlag, jsonlFlag, tableFlag, compactFlag bool, formatFlag string) Format {
 	if jsonFlag {
 		return FormatJSON
 	}
+	if jsonlFlag {
+		return FormatJSONL
+	}
 	if compactFlag {
 		return FormatCompact
 	}
 	if tableFlag {
 		return FormatTable
 	}
+	if f, ok := ParseFormat(formatFlag); ok {
+		return f
+	}
 
-	// Check environment variable.
-	switch os.Getenv("KANBAN_OUTPUT") {
-	case "json":
-		return FormatJSON
-	case "compact", "oneline":
-		return FormatCompact
-	case "table":
-		return FormatTable
+	// Check environment variables.
+	if f, ok := ParseFormat(os.Getenv("AGENTWATCH_OUTPUT")); ok {
+		return f
+	}
+	if f, ok := ParseFormat(os.Getenv("KANBAN_OUTPUT")); ok {
+		return f
 	}
 
 	// Default: table.
 	return FormatTable
 }
+
+// ParseFormat maps a format name to a Format. Returns false for unknown names.
+func ParseFormat(name string) (Format, bool) {
+	switch name {
+	case "json", formatJSONCompact:
+		return FormatJSON, true
+	case "compact", "oneline":
+		return FormatCompact, true
+	case "table":
+		return FormatTable, true
+	case "csv":
+		return FormatCSV, true
+	case "jsonl":
+		return FormatJSONL, true
+	}
+	return FormatAuto, false
+}
+
+// FormatNames returns the names accepted by ParseFormat.
+func FormatNames() []string {
+	return []string{"table", "json", formatJSONCompact, "jsonl", "compact", "csv"}
+}
+
+// formatJSONCompact is the format name for JSON written on a single line.
+const formatJSONCompact = "json-compact"
+
+// DetectCompactJSON reports whether JSON output should be written on a single
+// line. It follows the precedence of Detect: compactFlag (--json-compact),
+// then formatFlag, then AGENTWATCH_OUTPUT and KANBAN_OUTPUT. The first
+// format that is set decides.
+func DetectCompactJSON(compactFlag bool, formatFlag string) bool {
+	if compactFlag {
+		return true
+	}
+	for _, name := range []string{formatFlag, os.Getenv("AGENTWATCH_OUTPUT"), os.Getenv("KANBAN_OUTPUT")} {
+		if _, ok := ParseFormat(name); ok {
+			return name == formatJSONCompact
+		}
+	}
+	return false
+}
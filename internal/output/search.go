@@ -0,0 +1,70 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+)
+
+var highlightStyle = lipgloss.NewStyle().Bold(true).Underline(true)
+
+// SearchTable renders ranked search results, highlighting the query in titles.
+func SearchTable(w io.Writer, results []board.SearchResult, query string) {
+	if len(results) == 0 {
+		fmt.Fprintln(os.Stderr, "No tasks found.")
+		return
+	}
+
+	const pad = 2
+	idW, statusW, titleW := 4, 8, 5
+	for _, r := range results {
+		idW = max(idW, len(strconv.Itoa(r.ID))+pad)
+		statusW = max(statusW, len(r.Status)+pad)
+		titleW = max(titleW, min(len(r.Title)+pad, 50)) //nolint:mnd // max title column width
+	}
+
+	header := fmt.Sprintf("%-*s %-*s %-*s %s", idW, "ID", statusW, "STATUS", titleW, "TITLE", "MATCHED")
+	fmt.Fprintln(w, headerStyle.Render(header))
+
+	for _, r := range results {
+		title := r.Title
+		const maxTitle = 48
+		if len(title) > maxTitle {
+			title = title[:maxTitle-3] + "..."
+		}
+		row := fmt.Sprintf("%-*d %s %s %s",
+			idW, r.ID,
+			padRight(styledValue(r.Status, statusStyles), statusW),
+			padRight(highlight(title, query), titleW),
+			dimStyle.Render(strings.Join(r.Matched, ",")))
+		fmt.Fprintln(w, row)
+	}
+}
+
+// highlight emphasizes every case-insensitive occurrence of query in s.
+func highlight(s, query string) string {
+	lower, q := strings.ToLower(s), strings.ToLower(query)
+	// Lowercasing can change byte offsets for some non-ASCII text; skip
+	// highlighting then rather than splitting a rune.
+	if q == "" || len(lower) != len(s) {
+		return s
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, q)
+		if i < 0 {
+			b.WriteString(s)
+			return b.String()
+		}
+		b.WriteString(s[:i])
+		b.WriteString(highlightStyle.Render(s[i : i+len(q)]))
+		s, lower = s[i+len(q):], lower[i+len(q):]
+	}
+}
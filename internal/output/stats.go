@@ -0,0 +1,58 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+)
+
+// StatsTable renders flow metrics as a formatted report.
+func StatsTable(w io.Writer, s board.FlowStats) {
+	title := "Flow metrics"
+	if s.Since != nil {
+		title += " since " + s.Since.Format("2006-01-02")
+	}
+	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(title))
+	fmt.Fprintf(w, "Completed: %d tasks\n\n", s.Completed)
+
+	header := fmt.Sprintf("%-12s %6s %10s %10s %10s", "METRIC", "COUNT", "AVG", "MEDIAN", "P85")
+	fmt.Fprintln(w, headerStyle.Render(header))
+	printDurationStats(w, "Lead time", s.LeadTime)
+	printDurationStats(w, "Cycle time", s.CycleTime)
+	if s.MissingStarted > 0 {
+		fmt.Fprintln(w, dimStyle.Render(fmt.Sprintf("(%d completed tasks without a start time excluded from cycle time)", s.MissingStarted)))
+	}
+	if s.Estimates.Count > 0 {
+		fmt.Fprintf(w, "Estimates: %d tasks, actual/estimate %.2f (%d over, %d within)\n",
+			s.Estimates.Count, s.Estimates.MeanRatio, s.Estimates.Over, s.Estimates.Under)
+	}
+	if s.Logged.Count > 0 {
+		fmt.Fprintf(w, "Worklog: %d tasks, logged/estimate %.2f (%d over, %d within)\n",
+			s.Logged.Count, s.Logged.MeanRatio, s.Logged.Over, s.Logged.Under)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, headerStyle.Render(fmt.Sprintf("%-12s %6s", "WEEK", "DONE")))
+	for _, wc := range s.Throughput {
+		fmt.Fprintf(w, "%-12s %6d\n", wc.Week, wc.Count)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, headerStyle.Render(fmt.Sprintf("%-16s %6s", "WIP", "COUNT")))
+	for _, sc := range s.WIP {
+		const statusColW = 16
+		fmt.Fprintf(w, "%s %6d\n", padRight(styledValue(sc.Status, statusStyles), statusColW), sc.Count)
+	}
+}
+
+func printDurationStats(w io.Writer, label string, d board.DurationStats) {
+	if d.Count == 0 {
+		fmt.Fprintf(w, "%-12s %6d %10s %10s %10s\n", label, 0, "--", "--", "--")
+		return
+	}
+	fmt.Fprintf(w, "%-12s %6d %10s %10s %10s\n", label, d.Count,
+		FormatDuration(d.Mean), FormatDuration(d.Median), FormatDuration(d.P85))
+}
@@ -3,12 +3,15 @@ package output
 import (
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 
 	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
 	"github.com/twiced-technology-gmbh/agentwatch/internal/task"
@@ -38,81 +41,61 @@ var (
 
 	tagStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("110"))
 	claimStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("44")).Bold(true)
+	warnStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)
+	riskStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("208"))
 )
 
-// DisableColor strips all styling from table output.
+// colorDisabled records a DisableColor call so later restyling stays plain.
+var colorDisabled bool
+
+// DisableColor strips all styling from table output and from lipgloss
+// rendering generally, including the TUI.
 func DisableColor() {
+	colorDisabled = true
+	lipgloss.SetColorProfile(termenv.Ascii)
 	headerStyle = lipgloss.NewStyle()
 	dimStyle = lipgloss.NewStyle()
 	statusStyles = map[string]lipgloss.Style{}
 	priorityStyles = map[string]lipgloss.Style{}
 	tagStyle = lipgloss.NewStyle()
 	claimStyle = lipgloss.NewStyle()
+	warnStyle = lipgloss.NewStyle()
+	riskStyle = lipgloss.NewStyle()
+	highlightStyle = lipgloss.NewStyle()
+	diffAddStyle = lipgloss.NewStyle()
+	diffDelStyle = lipgloss.NewStyle()
 }
 
-// TaskTable renders a list of tasks as a formatted table.
-func TaskTable(w io.Writer, tasks []*task.Task) {
-	if len(tasks) == 0 {
-		fmt.Fprintln(os.Stderr, "No tasks found.")
+// ForceColor renders styles even when stdout is not a terminal, e.g. when
+// piping into "less -R".
+func ForceColor() {
+	lipgloss.SetColorProfile(termenv.ANSI256)
+}
+
+// SetPriorityColors applies configured priority colors (name to ANSI code).
+// Built-in styles keep their emphasis and take the new color; empty colors
+// are ignored.
+func SetPriorityColors(colors map[string]string) {
+	if colorDisabled {
 		return
 	}
-
-	// Calculate column widths.
-	const pad = 2
-	idW, statusW, prioW, titleW, claimW, tagsW, dueW := 4, 8, 10, 5, 9, 6, 12
-	for _, t := range tasks {
-		idW = max(idW, len(strconv.Itoa(t.ID))+pad)
-		statusW = max(statusW, len(t.Status)+pad)
-		prioW = max(prioW, len(t.Priority)+pad)
-		titleW = max(titleW, min(len(t.Title)+pad, 50)) //nolint:mnd // max title column width
-		claimW = max(claimW, len(claimDisplay(t))+pad)
-		tagsW = max(tagsW, min(len(strings.Join(t.Tags, ","))+pad, 30)) //nolint:mnd // max tags column width
-	}
-
-	// Print header.
-	header := fmt.Sprintf("%-*s %-*s %-*s %-*s %-*s %-*s %-*s",
-		idW, "ID", statusW, "STATUS", prioW, "PRIORITY",
-		titleW, "TITLE", claimW, "CLAIMED", tagsW, "TAGS", dueW, "DUE")
-	fmt.Fprintln(w, headerStyle.Render(strings.TrimRight(header, " ")))
-
-	// Print rows.
-	for _, t := range tasks {
-		title := t.Title
-		const maxTitle = 48
-		if len(title) > maxTitle {
-			title = title[:maxTitle-3] + "..."
+	for name, color := range colors {
+		if color == "" {
+			continue
 		}
-		claim := claimDisplay(t)
-		if claim == "" {
-			claim = dimStyle.Render("--")
-		} else {
-			claim = claimStyle.Render(claim)
+		st, ok := priorityStyles[name]
+		if !ok {
+			st = lipgloss.NewStyle()
 		}
-		tags := strings.Join(t.Tags, ",")
-		if tags == "" {
-			tags = dimStyle.Render("--")
-		} else {
-			tags = tagStyle.Render(tags)
-		}
-		due := "--"
-		if t.Due != nil {
-			due = t.Due.String()
-		} else {
-			due = dimStyle.Render(due)
-		}
-
-		row := fmt.Sprintf("%-*d %s %s %s %s %s %s",
-			idW, t.ID,
-			padRight(styledValue(t.Status, statusStyles), statusW),
-			padRight(styledValue(t.Priority, priorityStyles), prioW),
-			padRight(title, titleW),
-			padRight(claim, claimW),
-			padRight(tags, tagsW),
-			due)
-		fmt.Fprintln(w, strings.TrimRight(row, " "))
+		priorityStyles[name] = st.Foreground(lipgloss.Color(color))
 	}
 }
 
+// TaskTable renders a list of tasks as a formatted table.
+func TaskTable(w io.Writer, tasks []*task.Task) {
+	TaskTableFields(w, tasks, DefaultTaskFields)
+}
+
 // TaskDetail renders a single task with full detail.
 func TaskDetail(w io.Writer, t *task.Task) {
 	titleLine := fmt.Sprintf("Task #%d: %s", t.ID, t.Title)
@@ -130,12 +113,22 @@ func TaskDetail(w io.Writer, t *task.Task) {
 	} else {
 		printField(w, "Tags", dimStyle.Render("--"))
 	}
+	for i, l := range t.Links {
+		if i == 0 {
+			printField(w, "Links", l.String())
+			continue
+		}
+		fmt.Fprintf(w, "  %-12s %s\n", "", l.String())
+	}
 	if t.Due != nil {
 		printField(w, "Due", t.Due.String())
 	} else {
 		printField(w, "Due", dimStyle.Render("--"))
 	}
 	printField(w, "Estimate", stringOrDash(t.Estimate))
+	if est, actual, ok := task.EstimateVariance(t); ok {
+		printField(w, "Accuracy", formatVariance(est, actual))
+	}
 	printField(w, "Created", t.Created.Format("2006-01-02 15:04"))
 	printField(w, "Updated", t.Updated.Format("2006-01-02 15:04"))
 	if t.Started != nil {
@@ -148,18 +141,70 @@ func TaskDetail(w io.Writer, t *task.Task) {
 			printField(w, "Cycle time", FormatDuration(t.Completed.Sub(*t.Started)))
 		}
 	}
+	if len(t.Worklog) > 0 {
+		logged := FormatDuration(task.LoggedTime(t))
+		if e := task.OpenWorklog(t); e != nil {
+			logged += " (open since " + e.Start.Format("15:04") + ")"
+		}
+		printField(w, "Logged", logged)
+	}
 
 	if t.ClaimedBy != "" {
 		claimStr := claimStyle.Render(t.ClaimedBy)
 		if t.ClaimedAt != nil {
-			claimStr += " (since " + t.ClaimedAt.Format("2006-01-02 15:04") + ")"
+			claimStr += " (since " + t.ClaimedAt.Format("2006-01-02 15:04") + claimExpiry(*t.ClaimedAt) + ")"
 		}
 		printField(w, "Claimed by", claimStr)
 	}
 
 	if t.Body != "" {
 		fmt.Fprintln(w)
-		fmt.Fprintln(w, t.Body)
+		printBody(w, t.Body)
+	}
+}
+
+// maxLineLen bounds how much of a single body line is rendered, so a runaway
+// line cannot blow up the terminal layout.
+const maxLineLen = 1000
+
+// truncateLine shortens line to maxLineLen runes, ending in an ellipsis.
+func truncateLine(line string) string {
+	if len(line) <= maxLineLen {
+		return line
+	}
+	r := []rune(line)
+	if len(r) <= maxLineLen {
+		return line
+	}
+	return string(r[:maxLineLen-1]) + "…"
+}
+
+// printBody prints a task body, dimming comment header lines so comments
+// stand apart from the description.
+func printBody(w io.Writer, body string) {
+	for _, line := range strings.Split(body, "\n") {
+		line = truncateLine(line)
+		if task.IsCommentHeader(line) {
+			line = dimStyle.Render(line)
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+// CommentList renders comment blocks, each under a dim author/time header.
+func CommentList(w io.Writer, comments []task.Comment) {
+	if len(comments) == 0 {
+		fmt.Fprintln(w, "No comments.")
+		return
+	}
+	for i, c := range comments {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, dimStyle.Render(c.Author+" · "+c.Timestamp.Format("2006-01-02 15:04")))
+		for _, line := range strings.Split(c.Text, "\n") {
+			fmt.Fprintln(w, truncateLine(line))
+		}
 	}
 }
 
@@ -168,7 +213,7 @@ func OverviewTable(w io.Writer, s board.Overview) {
 	fmt.Fprintln(w, lipgloss.NewStyle().Bold(true).Render(s.BoardName))
 	fmt.Fprintf(w, "Total: %d tasks\n\n", s.TotalTasks)
 
-	header := fmt.Sprintf("%-16s %6s %8s %8s %8s", "STATUS", "COUNT", "WIP", "BLOCKED", "OVERDUE")
+	header := fmt.Sprintf("%-16s %6s %8s %8s %8s %8s %8s", "STATUS", "COUNT", "WIP", "BLOCKED", "OVERDUE", "AVG AGE", "OLDEST")
 	fmt.Fprintln(w, headerStyle.Render(header))
 
 	for _, ss := range s.Statuses {
@@ -176,10 +221,24 @@ func OverviewTable(w io.Writer, s board.Overview) {
 		if ss.WIPLimit > 0 {
 			wip = strconv.Itoa(ss.Count) + "/" + strconv.Itoa(ss.WIPLimit)
 		}
+		if ss.OverWIP {
+			wip = warnStyle.Render(wip + " !")
+		}
 		const statusColW = 16
-		fmt.Fprintf(w, "%s %6d %s %8d %8d\n",
+		fmt.Fprintf(w, "%s %6d %s %8d %8d %8s %8s\n",
 			padRight(styledValue(ss.Status, statusStyles), statusColW),
-			ss.Count, padRight(wip, 8), ss.Blocked, ss.Overdue) //nolint:mnd // column width
+			ss.Count, padRight(wip, 8), ss.Blocked, ss.Overdue, //nolint:mnd // column width
+			ageOrDash(ss.Count, ss.AvgAge), ageOrDash(ss.Count, ss.OldestAge))
+	}
+	atRisk := 0
+	for _, ss := range s.Statuses {
+		atRisk += ss.AtRisk
+	}
+	if atRisk > 0 {
+		fmt.Fprintln(w, riskStyle.Render(fmt.Sprintf("At risk: %d task(s) within their class escalation window", atRisk)))
+	}
+	for _, ss := range s.UnderMin() {
+		fmt.Fprintln(w, warnStyle.Render(fmt.Sprintf("Replenish %s: %d of minimum %d", ss.Status, ss.Count, ss.WIPMinimum)))
 	}
 
 	fmt.Fprintln(w)
@@ -202,6 +261,83 @@ func OverviewTable(w io.Writer, s board.Overview) {
 	}
 }
 
+// AssigneeOverviewTable renders OverviewTable once per assignee, titled with
+// the assignee's name.
+func AssigneeOverviewTable(w io.Writer, overviews []board.AssigneeOverview) {
+	for i, ao := range overviews {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		ov := ao.Overview
+		ov.BoardName = ao.Assignee
+		OverviewTable(w, ov)
+	}
+}
+
+// ageOrDash renders a status age for OverviewTable, or "--" for an empty
+// column or when ages were not computed.
+func ageOrDash(count int, d time.Duration) string {
+	if count == 0 || d == 0 {
+		return "--"
+	}
+	return HumanDuration(d)
+}
+
+// HistoryTable renders a task's timeline with aligned timestamps. Move entries
+// show the old and new status colored like the status column.
+func HistoryTable(w io.Writer, h board.History) {
+	if len(h.Entries) == 0 {
+		fmt.Fprintln(os.Stderr, "No history found.")
+		return
+	}
+
+	const actionW = 12
+	header := fmt.Sprintf("%-16s %-*s %s", "TIME", actionW, "ACTION", "DETAIL")
+	fmt.Fprintln(w, headerStyle.Render(header))
+
+	for _, e := range h.Entries {
+		detail := e.Detail
+		action := e.Action
+		if e.From != "" || e.To != "" {
+			detail = styledValue(e.From, statusStyles) + " -> " + styledValue(e.To, statusStyles)
+			if st, ok := statusStyles[e.To]; ok {
+				action = st.Render(e.Action)
+			}
+			if e.Reason != "" {
+				detail += dimStyle.Render(" (" + e.Reason + ")")
+			}
+		}
+		if len(e.Changes) > 0 {
+			detail = formatChanges(e.Changes)
+		}
+		if e.Actor != "" {
+			detail += dimStyle.Render(" by " + e.Actor)
+		}
+		row := fmt.Sprintf("%s %s %s",
+			dimStyle.Render(e.Timestamp.Format("2006-01-02 15:04")),
+			padRight(action, actionW), detail)
+		fmt.Fprintln(w, strings.TrimRight(row, " "))
+	}
+}
+
+// formatChanges renders edited fields as "field: old -> new" in name order.
+func formatChanges(changes map[string]board.FieldChange) string {
+	names := slices.Sorted(maps.Keys(changes))
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		c := changes[name]
+		parts = append(parts, fmt.Sprintf("%s: %s -> %s", name, valueOrDash(c.Old), valueOrDash(c.New)))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// valueOrDash returns v, or "--" when it is empty.
+func valueOrDash(v string) string {
+	if v == "" {
+		return "--"
+	}
+	return v
+}
 
 // GroupedTable renders a grouped board view with per-group status breakdowns.
 func GroupedTable(w io.Writer, gs board.GroupedSummary) {
@@ -210,6 +346,17 @@ func GroupedTable(w io.Writer, gs board.GroupedSummary) {
 		return
 	}
 
+	// Size the status column to the longest name shown in any group so
+	// counts line up across groups.
+	statusW := 16 //nolint:mnd // minimum status column width
+	for _, g := range gs.Groups {
+		for _, ss := range g.Statuses {
+			if ss.Count > 0 {
+				statusW = max(statusW, len(ss.Status)+1)
+			}
+		}
+	}
+
 	for i, g := range gs.Groups {
 		if i > 0 {
 			fmt.Fprintln(w)
@@ -221,15 +368,26 @@ func GroupedTable(w io.Writer, gs board.GroupedSummary) {
 			if ss.Count == 0 {
 				continue
 			}
-			const groupStatusW = 16
 			fmt.Fprintf(w, "  %s %d\n",
-				padRight(styledValue(ss.Status, statusStyles), groupStatusW), ss.Count)
+				padRight(styledValue(ss.Status, statusStyles), statusW), ss.Count)
 		}
 	}
 }
 
-// Messagef prints a simple formatted message line.
+// quiet suppresses Messagef output when set via SetQuiet.
+var quiet bool
+
+// SetQuiet enables or disables suppression of Messagef output. Errors and
+// requested data (tables, JSON) are unaffected.
+func SetQuiet(q bool) {
+	quiet = q
+}
+
+// Messagef prints a simple formatted message line, unless quiet mode is on.
 func Messagef(w io.Writer, format string, args ...interface{}) {
+	if quiet {
+		return
+	}
 	fmt.Fprintf(w, format+"\n", args...)
 }
 
@@ -249,6 +407,40 @@ func FormatDuration(d time.Duration) string {
 	return strconv.Itoa(hours) + "h " + strconv.Itoa(minutes) + "m"
 }
 
+// HumanDuration formats a duration as a compact human-readable string.
+// Examples: "<1m", "5m", "2h", "3d", "2w", "3mo", "1y".
+func HumanDuration(d time.Duration) string {
+	const (
+		day   = 24 * time.Hour
+		week  = 7 * day
+		month = 30 * day
+		year  = 365 * day
+	)
+
+	switch {
+	case d < time.Minute:
+		return "<1m"
+	case d < time.Hour:
+		return strconv.Itoa(int(d.Minutes())) + "m"
+	case d < day:
+		return strconv.Itoa(int(d.Hours())) + "h"
+	case d < week:
+		return strconv.Itoa(int(d/day)) + "d"
+	case d < month:
+		return strconv.Itoa(int(d/week)) + "w"
+	case d < year:
+		return strconv.Itoa(int(d/month)) + "mo"
+	default:
+		return strconv.Itoa(int(d/year)) + "y"
+	}
+}
+
+// formatVariance renders actual time against an estimate, e.g. "1d 2h actual (+25%)".
+func formatVariance(est, actual time.Duration) string {
+	pct := int((float64(actual)/float64(est) - 1) * 100) //nolint:mnd // percent
+	return fmt.Sprintf("%s actual (%+d%%)", FormatDuration(actual), pct)
+}
+
 // padRight pads s with spaces to the given visible width, accounting for ANSI
 // escape codes that are invisible but consume bytes.
 func padRight(s string, width int) string {
@@ -267,6 +459,28 @@ func stringOrDash(s string) string {
 }
 
 // claimDisplay returns "@agent" if the task is claimed, or "" otherwise.
+// claimTimeout is the board's claim expiry, used by TaskDetail. Zero means
+// claims do not expire.
+var claimTimeout time.Duration
+
+// SetClaimTimeout sets the claim expiry shown in task details.
+func SetClaimTimeout(d time.Duration) {
+	claimTimeout = d
+}
+
+// claimExpiry describes when a claim made at claimedAt expires, as in
+// task.CheckClaim, or "" when claims do not expire.
+func claimExpiry(claimedAt time.Time) string {
+	if claimTimeout <= 0 {
+		return ""
+	}
+	remaining := claimTimeout - time.Since(claimedAt)
+	if remaining <= 0 {
+		return ", " + dimStyle.Render("expired")
+	}
+	return ", expires in " + remaining.Truncate(time.Minute).String()
+}
+
 func claimDisplay(t *task.Task) string {
 	if t.ClaimedBy != "" {
 		return "@" + t.ClaimedBy
@@ -0,0 +1,82 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+)
+
+// Box-drawing connectors for tree output.
+const (
+	treeBranch = "├── "
+	treeLast   = "└── "
+	treePipe   = "│   "
+	treeSpace  = "    "
+)
+
+// DepTree renders a dependency tree with box-drawing connectors.
+func DepTree(w io.Writer, root *board.DepNode) {
+	fmt.Fprintln(w, depNodeLine(root))
+	printDepChildren(w, root.Children, "")
+}
+
+func printDepChildren(w io.Writer, children []*board.DepNode, prefix string) {
+	for i, c := range children {
+		connector, indent := treeBranch, treePipe
+		if i == len(children)-1 {
+			connector, indent = treeLast, treeSpace
+		}
+		fmt.Fprintln(w, dimStyle.Render(prefix+connector)+depNodeLine(c))
+		printDepChildren(w, c.Children, prefix+indent)
+	}
+}
+
+func depNodeLine(n *board.DepNode) string {
+	if n.Missing {
+		return fmt.Sprintf("#%d %s", n.ID, dimStyle.Render("(not found)"))
+	}
+	line := fmt.Sprintf("#%d %s [%s]", n.ID, n.Title, styledValue(n.Status, statusStyles))
+	if n.Done {
+		line += " " + statusStyles["done"].Render("✓")
+	}
+	if n.Cycle {
+		line += " " + dimStyle.Render("(cycle)")
+	}
+	return line
+}
+
+// TaskTree renders a parent/child forest with child progress summaries.
+func TaskTree(w io.Writer, roots []*board.TreeNode) {
+	if len(roots) == 0 {
+		fmt.Fprintln(os.Stderr, "No tasks found.")
+		return
+	}
+	for _, n := range roots {
+		fmt.Fprintln(w, treeNodeLine(n))
+		printTreeChildren(w, n.Children, "")
+	}
+}
+
+func printTreeChildren(w io.Writer, children []*board.TreeNode, prefix string) {
+	for i, c := range children {
+		connector, indent := treeBranch, treePipe
+		if i == len(children)-1 {
+			connector, indent = treeLast, treeSpace
+		}
+		fmt.Fprintln(w, dimStyle.Render(prefix+connector)+treeNodeLine(c))
+		printTreeChildren(w, c.Children, prefix+indent)
+	}
+}
+
+func treeNodeLine(n *board.TreeNode) string {
+	line := fmt.Sprintf("#%d %s [%s]", n.ID, n.Title, styledValue(n.Status, statusStyles))
+	if n.ChildrenTotal > 0 {
+		line += " " + dimStyle.Render(fmt.Sprintf("[%d/%d done]", n.ChildrenDone, n.ChildrenTotal))
+	}
+	if n.Orphan != "" {
+		line = priorityStyles["high"].Render("!") + " " + line + " " + dimStyle.Render("("+n.Orphan+")")
+	}
+	return line
+}
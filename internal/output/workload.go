@@ -0,0 +1,29 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/board"
+)
+
+// WorkloadTable renders open work per assignee, with unassigned work last.
+func WorkloadTable(w io.Writer, wl board.Workload) {
+	const nameW = 20
+	header := fmt.Sprintf("%-*s %6s %10s %12s", nameW, "ASSIGNEE", "TASKS", "ESTIMATE", "UNESTIMATED")
+	fmt.Fprintln(w, headerStyle.Render(header))
+	for _, a := range wl.Assignees {
+		printWorkloadRow(w, a.Assignee, a, nameW)
+	}
+	if wl.Unassigned.Tasks > 0 {
+		printWorkloadRow(w, dimStyle.Render("(unassigned)"), wl.Unassigned, nameW)
+	}
+}
+
+func printWorkloadRow(w io.Writer, name string, a board.AssigneeWorkload, nameW int) {
+	total := "--"
+	if a.Total > 0 {
+		total = FormatDuration(a.Total)
+	}
+	fmt.Fprintf(w, "%s %6d %10s %12d\n", padRight(name, nameW), a.Tasks, total, a.Unestimated)
+}
@@ -0,0 +1,73 @@
+package task
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// commentTimeLayout is the timestamp format used in comment headers.
+const commentTimeLayout = "2006-01-02 15:04 -0700"
+
+// commentHeaderRe matches a comment header line written by FormatComment.
+var commentHeaderRe = regexp.MustCompile(`^#### Comment by (.+) at (\d{4}-\d{2}-\d{2} \d{2}:\d{2} [+-]\d{4})$`)
+
+// Comment is a structured note appended to a task body.
+type Comment struct {
+	Author    string    `json:"author"`
+	Timestamp time.Time `json:"timestamp"`
+	Text      string    `json:"text"`
+}
+
+// FormatComment renders a comment block: a header line followed by the text.
+func FormatComment(c Comment) string {
+	return "#### Comment by " + c.Author + " at " + c.Timestamp.Format(commentTimeLayout) +
+		"\n" + strings.TrimRight(c.Text, "\n")
+}
+
+// AppendComment appends a formatted comment block to the task body.
+func AppendComment(t *Task, c Comment) {
+	block := FormatComment(c)
+	if t.Body == "" {
+		t.Body = block
+		return
+	}
+	t.Body = strings.TrimRight(t.Body, "\n") + "\n\n" + block
+}
+
+// IsCommentHeader reports whether line is a comment header.
+func IsCommentHeader(line string) bool {
+	return commentHeaderRe.MatchString(line)
+}
+
+// ParseComments extracts the comment blocks from a task body in order. Each
+// comment's text runs until the next comment header or the end of the body.
+func ParseComments(body string) []Comment {
+	var comments []Comment
+	var cur *Comment
+	var text []string
+
+	flush := func() {
+		if cur != nil {
+			cur.Text = strings.TrimSpace(strings.Join(text, "\n"))
+			comments = append(comments, *cur)
+		}
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		m := commentHeaderRe.FindStringSubmatch(line)
+		if m == nil {
+			if cur != nil {
+				text = append(text, line)
+			}
+			continue
+		}
+		flush()
+		ts, _ := time.Parse(commentTimeLayout, m[2]) // zero time if unparseable
+		cur = &Comment{Author: m[1], Timestamp: ts}
+		text = nil
+	}
+	flush()
+
+	return comments
+}
@@ -0,0 +1,96 @@
+package task
+
+// taskLoader reads tasks by ID on demand and caches the results. Missing or
+// unreadable tasks load as nil; existence is checked separately by
+// ValidateDependencyIDs.
+type taskLoader struct {
+	tasksDir string
+	cache    map[int]*Task
+}
+
+func newTaskLoader(tasksDir string, self *Task) *taskLoader {
+	// The task being modified is used as-is so pending edits are checked.
+	return &taskLoader{tasksDir: tasksDir, cache: map[int]*Task{self.ID: self}}
+}
+
+func (l *taskLoader) load(id int) *Task {
+	if t, ok := l.cache[id]; ok {
+		return t
+	}
+	var t *Task
+	if path, err := FindByID(l.tasksDir, id); err == nil {
+		t, _ = Read(path)
+	}
+	l.cache[id] = t
+	return t
+}
+
+// ValidateNoCycles checks that t's dependencies and parent chain do not lead
+// back to t. Each reachable task is visited at most once, so shared
+// dependencies (diamonds) are allowed and the walk is bounded by the number
+// of tasks.
+func ValidateNoCycles(tasksDir string, t *Task) error {
+	l := newTaskLoader(tasksDir, t)
+
+	if path := findDependencyCycle(l, t); path != nil {
+		return ValidateDependencyCycle("dependency", path)
+	}
+	if path := findParentCycle(l, t); path != nil {
+		return ValidateDependencyCycle("parent", path)
+	}
+	return nil
+}
+
+// findDependencyCycle walks DependsOn depth-first from t and returns the path
+// t -> ... -> t if t is reachable from itself, or nil.
+func findDependencyCycle(l *taskLoader, t *Task) []int {
+	visited := map[int]bool{}
+	var path []int
+
+	var walk func(id int) bool
+	walk = func(id int) bool {
+		path = append(path, id)
+		if id == t.ID && len(path) > 1 {
+			return true
+		}
+		if visited[id] {
+			path = path[:len(path)-1]
+			return false
+		}
+		visited[id] = true
+		if cur := l.load(id); cur != nil {
+			for _, dep := range cur.DependsOn {
+				if walk(dep) {
+					return true
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+
+	if walk(t.ID) {
+		return path
+	}
+	return nil
+}
+
+// findParentCycle follows the parent chain from t and returns the path
+// t -> ... -> t if it loops back to t, or nil.
+func findParentCycle(l *taskLoader, t *Task) []int {
+	path := []int{t.ID}
+	seen := map[int]bool{t.ID: true}
+	for cur := t; cur != nil && cur.Parent != nil; {
+		id := *cur.Parent
+		path = append(path, id)
+		if id == t.ID {
+			return path
+		}
+		if seen[id] {
+			return nil // a loop above t that does not include t
+		}
+		seen[id] = true
+		cur = l.load(id)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+)
+
+// writeTasks writes tasks to a fresh tasks directory in the default
+// filename format.
+func writeTasks(t *testing.T, tasks ...*Task) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, tk := range tasks {
+		if err := Write(filepath.Join(dir, fmt.Sprintf("%03d-task.md", tk.ID)), tk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func intPtr(n int) *int { return &n }
+
+func TestValidateNoCycles(t *testing.T) {
+	// Diamond: 4 depends on 2 and 3, which both depend on 1.
+	dir := writeTasks(t,
+		&Task{ID: 1, Title: "base", Status: "todo"},
+		&Task{ID: 2, Title: "left", Status: "todo", DependsOn: []int{1}},
+		&Task{ID: 3, Title: "right", Status: "todo", DependsOn: []int{1}},
+		&Task{ID: 4, Title: "top", Status: "todo", DependsOn: []int{2, 3}},
+		&Task{ID: 5, Title: "epic", Status: "todo", Parent: intPtr(6)},
+		&Task{ID: 6, Title: "theme", Status: "todo"},
+	)
+
+	tests := []struct {
+		name      string
+		task      *Task
+		wantCycle string
+	}{
+		{"diamond is legal", &Task{ID: 4, DependsOn: []int{2, 3}}, ""},
+		{"new task on a diamond", &Task{ID: 7, DependsOn: []int{4, 1}}, ""},
+		{"dependency cycle", &Task{ID: 1, DependsOn: []int{4}}, "dependency cycle: #1 -> #4 -> #2 -> #1"},
+		{"direct dependency cycle", &Task{ID: 2, DependsOn: []int{1, 4}}, "dependency cycle: #2 -> #4 -> #2"},
+		{"parent chain is legal", &Task{ID: 7, Parent: intPtr(5)}, ""},
+		{"parent cycle", &Task{ID: 6, Parent: intPtr(5)}, "parent cycle: #6 -> #5 -> #6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNoCycles(dir, tt.task)
+			if tt.wantCycle == "" {
+				if err != nil {
+					t.Fatalf("ValidateNoCycles() = %v, want nil", err)
+				}
+				return
+			}
+			var cliErr *clierr.Error
+			if !errors.As(err, &cliErr) || cliErr.Code != clierr.DependencyCycle {
+				t.Fatalf("ValidateNoCycles() = %v, want %s error", err, clierr.DependencyCycle)
+			}
+			if cliErr.Message != tt.wantCycle {
+				t.Errorf("message = %q, want %q", cliErr.Message, tt.wantCycle)
+			}
+		})
+	}
+}
+
+func TestValidateDependencyIDs(t *testing.T) {
+	dir := writeTasks(t,
+		&Task{ID: 1, Title: "one", Status: "todo"},
+		&Task{ID: 2, Title: "two", Status: "todo"},
+	)
+
+	tests := []struct {
+		name     string
+		ids      []int
+		wantCode string
+	}{
+		{"existing", []int{1, 2}, ""},
+		{"self reference", []int{1, 3}, clierr.SelfReference},
+		{"missing", []int{1, 9}, clierr.DependencyNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDependencyIDs(dir, 3, tt.ids)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("ValidateDependencyIDs() = %v, want nil", err)
+				}
+				return
+			}
+			var cliErr *clierr.Error
+			if !errors.As(err, &cliErr) || cliErr.Code != tt.wantCode {
+				t.Errorf("ValidateDependencyIDs() = %v, want %s error", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package task
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldChange is one field that differs between two tasks.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// diffFields lists the compared fields in display order. IDs, timestamps and
+// time logs are skipped: they always differ between a task and its copy.
+var diffFields = []struct {
+	name  string
+	value func(*Task) string
+}{
+	{"title", func(t *Task) string { return t.Title }},
+	{"status", func(t *Task) string { return t.Status }},
+	{"priority", func(t *Task) string { return t.Priority }},
+	{"assignee", func(t *Task) string { return t.Assignee }},
+	{"tags", func(t *Task) string { return strings.Join(t.Tags, ",") }},
+	{"links", func(t *Task) string { return FormatLinks(t.Links) }},
+	{"due", func(t *Task) string {
+		if t.Due == nil {
+			return ""
+		}
+		return t.Due.String()
+	}},
+	{"estimate", func(t *Task) string { return t.Estimate }},
+	{"class", func(t *Task) string { return t.Class }},
+	{"parent", func(t *Task) string {
+		if t.Parent == nil {
+			return ""
+		}
+		return strconv.Itoa(*t.Parent)
+	}},
+	{"depends_on", func(t *Task) string {
+		ids := make([]string, len(t.DependsOn))
+		for i, id := range t.DependsOn {
+			ids[i] = strconv.Itoa(id)
+		}
+		return strings.Join(ids, ",")
+	}},
+	{"blocked", func(t *Task) string { return strconv.FormatBool(t.Blocked) }},
+	{"block_reason", func(t *Task) string { return t.BlockReason }},
+	{"claimed_by", func(t *Task) string { return t.ClaimedBy }},
+}
+
+// Diff returns the fields that differ from a to b. A differing body is
+// reported as a "body" change with the line counts; use DiffLines for its
+// content.
+func Diff(a, b *Task) []FieldChange {
+	var changes []FieldChange
+	for _, f := range diffFields {
+		if va, vb := f.value(a), f.value(b); va != vb {
+			changes = append(changes, FieldChange{Field: f.name, Old: va, New: vb})
+		}
+	}
+	if normalizeBody(a.Body) != normalizeBody(b.Body) {
+		changes = append(changes, FieldChange{
+			Field: "body",
+			Old:   fmt.Sprintf("%d lines", lineCount(a.Body)),
+			New:   fmt.Sprintf("%d lines", lineCount(b.Body)),
+		})
+	}
+	return changes
+}
+
+func lineCount(s string) int {
+	s = normalizeBody(s)
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, "\n") + 1
+}
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// DiffLines returns a unified diff of two texts as lines, without file
+// headers: "@@" hunk headers followed by lines prefixed with " ", "-" or
+// "+". Identical texts yield nil.
+func DiffLines(a, b string) []string {
+	ops := diffOps(splitLines(a), splitLines(b))
+
+	var out []string
+	prevEnd := 0
+	for i := 0; i < len(ops); {
+		for i < len(ops) && ops[i].kind == ' ' {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+
+		// Extend the hunk while changes are close enough to share context.
+		last := i
+		for j := i + 1; j < len(ops) && j-last-1 <= 2*diffContext; j++ {
+			if ops[j].kind != ' ' {
+				last = j
+			}
+		}
+		from := max(i-diffContext, prevEnd)
+		to := min(last+1+diffContext, len(ops))
+
+		out = append(out, hunkHeader(ops, from, to))
+		for _, op := range ops[from:to] {
+			out = append(out, string(op.kind)+op.line)
+		}
+		i, prevEnd = to, to
+	}
+	return out
+}
+
+func hunkHeader(ops []diffOp, from, to int) string {
+	aStart, bStart := 1, 1
+	for _, op := range ops[:from] {
+		if op.kind != '+' {
+			aStart++
+		}
+		if op.kind != '-' {
+			bStart++
+		}
+	}
+	aLen, bLen := 0, 0
+	for _, op := range ops[from:to] {
+		if op.kind != '+' {
+			aLen++
+		}
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", aStart, aLen, bStart, bLen)
+}
+
+type diffOp struct {
+	kind byte // ' ', '-', '+'
+	line string
+}
+
+// maxDiffCells caps the LCS table (len(a)*len(b) after trimming the common
+// prefix and suffix). Larger differences are reported as a full replacement
+// of the differing lines rather than spending quadratic time and memory.
+const maxDiffCells = 4_000_000
+
+// diffOps computes a line edit script from a to b using the longest common
+// subsequence of the lines between their common prefix and suffix.
+func diffOps(a, b []string) []diffOp {
+	ops := make([]diffOp, 0, len(a)+len(b))
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		ops = append(ops, diffOp{' ', a[prefix]})
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops = append(ops, lcsOps(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+	return ops
+}
+
+// lcsOps diffs a and b with an LCS table, or as a deletion of a followed by
+// an insertion of b when the table would exceed maxDiffCells.
+func lcsOps(a, b []string) []diffOp {
+	ops := make([]diffOp, 0, len(a)+len(b))
+	if len(a)*len(b) > maxDiffCells {
+		for _, line := range a {
+			ops = append(ops, diffOp{'-', line})
+		}
+		for _, line := range b {
+			ops = append(ops, diffOp{'+', line})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{'+', b[j]})
+	}
+	return ops
+}
+
+func splitLines(s string) []string {
+	s = normalizeBody(s)
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
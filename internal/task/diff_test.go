@@ -0,0 +1,76 @@
+package task
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []string
+	}{
+		{"identical", "a\nb", "a\nb", nil},
+		{"append", "a\nb", "a\nb\nc", []string{"@@ -1,2 +1,3 @@", " a", " b", "+c"}},
+		{"replace middle", "a\nb\nc", "a\nx\nc", []string{"@@ -1,3 +1,3 @@", " a", "-b", "+x", " c"}},
+		{"from empty", "", "a", []string{"@@ -1,0 +1,1 @@", "+a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffLines(tt.a, tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("DiffLines = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func numberedLines(prefix string, n int) []string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("%s %d", prefix, i)
+	}
+	return lines
+}
+
+func TestDiffOpsLargeInputFallsBackToReplace(t *testing.T) {
+	const n = 3000 // n*n exceeds maxDiffCells
+	a := append([]string{"head"}, numberedLines("old", n)...)
+	a = append(a, "tail")
+	b := append([]string{"head"}, numberedLines("new", n)...)
+	b = append(b, "tail")
+
+	ops := diffOps(a, b)
+
+	if len(ops) != 2*n+2 {
+		t.Fatalf("got %d ops, want %d", len(ops), 2*n+2)
+	}
+	if ops[0] != (diffOp{' ', "head"}) || ops[len(ops)-1] != (diffOp{' ', "tail"}) {
+		t.Errorf("common prefix/suffix not kept: first %v last %v", ops[0], ops[len(ops)-1])
+	}
+	for i, op := range ops[1 : len(ops)-1] {
+		want := byte('-')
+		if i >= n {
+			want = '+'
+		}
+		if op.kind != want {
+			t.Fatalf("op %d = %q, want %q", i+1, op.kind, want)
+		}
+	}
+}
+
+func TestDiffOpsTrimsCommonLinesBeforeLCS(t *testing.T) {
+	const n = 5000 // a full table would be n*n cells
+	a := numberedLines("line", n)
+	b := slices.Clone(a)
+	b[n/2] = "changed"
+
+	got := DiffLines(strings.Join(a, "\n"), strings.Join(b, "\n"))
+
+	want := fmt.Sprintf("@@ -%d,7 +%d,7 @@", n/2-2, n/2-2)
+	if len(got) != 9 || got[0] != want || got[4] != "-line 2500" || got[5] != "+changed" {
+		t.Errorf("DiffLines = %q, want one hunk %s replacing line 2500", got, want)
+	}
+}
@@ -0,0 +1,48 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+)
+
+// ParseEstimate parses an estimate such as "4h", "2d", or "1w" into a duration.
+// Days and weeks are calendar units (24h and 7d) so estimates compare directly
+// against cycle time.
+func ParseEstimate(s string) (time.Duration, error) {
+	d, err := date.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, errors.New("estimate must be positive")
+	}
+	return d, nil
+}
+
+// ValidateEstimate returns a CLIError for invalid estimate input.
+func ValidateEstimate(input string, err error) *clierr.Error {
+	return clierr.Newf(clierr.InvalidInput,
+		"invalid estimate %q: expected a positive duration like 4h, 2d, or 1w", input).
+		WithDetails(map[string]any{
+			"field": "estimate",
+			"input": input,
+			"error": err.Error(),
+		})
+}
+
+// EstimateVariance compares a completed task's cycle time with its estimate.
+// Returns ok=false when the task has no parseable estimate or is not yet
+// completed with a start time.
+func EstimateVariance(t *Task) (estimate, actual time.Duration, ok bool) {
+	if t.Estimate == "" || t.Started == nil || t.Completed == nil {
+		return 0, 0, false
+	}
+	est, err := ParseEstimate(t.Estimate)
+	if err != nil {
+		return 0, 0, false
+	}
+	return est, t.Completed.Sub(*t.Started), true
+}
@@ -5,9 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 
 	"go.yaml.in/yaml/v3"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/atomicfile"
 )
 
 const fileMode = 0o600
@@ -19,48 +22,97 @@ func Read(path string) (*Task, error) {
 		return nil, fmt.Errorf("reading task file: %w", err)
 	}
 
-	fm, body, err := splitFrontmatter(data)
+	t, err := Parse(data)
 	if err != nil {
 		return nil, fmt.Errorf("parsing %s: %w", path, err)
 	}
+	t.File = path
+
+	return t, nil
+}
+
+// Parse decodes task file content (YAML frontmatter plus markdown body).
+func Parse(data []byte) (*Task, error) {
+	fm, body, err := splitFrontmatter(data)
+	if err != nil {
+		return nil, err
+	}
 
 	var t Task
 	if err := yaml.Unmarshal(fm, &t); err != nil {
-		return nil, fmt.Errorf("parsing frontmatter in %s: %w", path, err)
+		return nil, fmt.Errorf("parsing frontmatter: %w", err)
+	}
+	t.Body = normalizeBody(body)
+	migrateTimeLog(&t)
+	t.TotalLoggedSeconds = int64(LoggedTime(&t).Seconds())
+	if t.Rank != "" && !ValidRank(t.Rank) {
+		t.Rank = "" // hand-edited or foreign rank: treat as unranked
 	}
-
-	t.Body = body
-	t.File = path
 
 	return &t, nil
 }
 
-// Write serializes a task to a markdown file with YAML frontmatter.
+// Write serializes a task to a markdown file with YAML frontmatter. The file
+// is replaced atomically, so a crash mid-write keeps the previous content.
 func Write(path string, t *Task) error {
+	data, err := Marshal(t)
+	if err != nil {
+		return err
+	}
+	return atomicfile.Write(path, data, fileMode)
+}
+
+// Restore writes data, previously read from a task file, back to path.
+func Restore(path string, data []byte) error {
+	return atomicfile.Write(path, data, fileMode)
+}
+
+// Marshal encodes a task as file content: YAML frontmatter followed by the
+// body, if any.
+func Marshal(t *Task) ([]byte, error) {
 	fm, err := yaml.Marshal(t)
 	if err != nil {
-		return fmt.Errorf("marshaling frontmatter: %w", err)
+		return nil, fmt.Errorf("marshaling frontmatter: %w", err)
 	}
 
 	var buf bytes.Buffer
 	buf.WriteString("---\n")
 	buf.Write(fm)
 	buf.WriteString("---\n")
-	if t.Body != "" {
+	if body := normalizeBody(t.Body); body != "" {
+		buf.WriteString("\n")
+		buf.WriteString(body)
 		buf.WriteString("\n")
-		buf.WriteString(t.Body)
-		if !strings.HasSuffix(t.Body, "\n") {
-			buf.WriteString("\n")
-		}
 	}
 
-	return os.WriteFile(path, buf.Bytes(), fileMode)
+	return buf.Bytes(), nil
+}
+
+// normalizeBody returns the canonical form of a task body: trailing line
+// breaks (including a stray "\r", which would pair with the final newline
+// into a CRLF) are dropped and a whitespace-only body is empty. Read and
+// Write both apply it so that reading and rewriting a task file is
+// byte-stable.
+func normalizeBody(body string) string {
+	if strings.TrimSpace(body) == "" {
+		return ""
+	}
+	return strings.TrimRight(body, "\r\n")
 }
 
+// utf8BOM is the byte order mark some Windows editors prepend to UTF-8 files.
+const utf8BOM = "\ufeff"
+
+// crlfRe matches a CRLF line break along with any stray carriage returns
+// before it, so normalizing leaves no "\r\n" behind.
+var crlfRe = regexp.MustCompile(`\r+\n`)
+
 // splitFrontmatter splits a markdown file into YAML frontmatter and body.
-// The file must start with "---\n". Returns frontmatter bytes and body string.
+// The file must start with "---\n". A leading UTF-8 BOM is ignored and CRLF
+// line endings are normalized to LF. Returns frontmatter bytes and body string.
 func splitFrontmatter(data []byte) ([]byte, string, error) {
-	content := string(data)
+	content := strings.TrimPrefix(string(data), utf8BOM)
+	content = crlfRe.ReplaceAllString(content, "\n")
 
 	if !strings.HasPrefix(content, "---\n") {
 		return nil, "", errors.New("file does not start with YAML frontmatter (---)")
@@ -0,0 +1,183 @@
+package task
+
+import (
+	"bytes"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitFrontmatter(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantFM   string
+		wantBody string
+		wantErr  bool
+	}{
+		{"lf", "---\nid: 1\n---\nbody\n", "id: 1", "body\n", false},
+		{"crlf", "---\r\nid: 1\r\ntitle: x\r\n---\r\nline one\r\nline two\r\n", "id: 1\ntitle: x", "line one\nline two\n", false},
+		{"bom", "\ufeff---\nid: 1\n---\nbody", "id: 1", "body", false},
+		{"bom and crlf", "\ufeff---\r\nid: 1\r\n---\r\nbody\r\n", "id: 1", "body\n", false},
+		{"stray cr before crlf", "---\r\nid: 1\r\n---\r\nline\r\r\nnext", "id: 1", "line\nnext", false},
+		{"no body", "---\nid: 1\n---\n", "id: 1", "", false},
+		{"closing at eof", "---\nid: 1\n---", "id: 1\n", "", false},
+		{"crlf closing at eof", "---\r\nid: 1\r\n---", "id: 1\n", "", false},
+		{"blank lines before body", "---\nid: 1\n---\n\n\nbody", "id: 1", "body", false},
+		{"bom inside is kept", "---\nid: 1\n---\n\ufeffbody", "id: 1", "\ufeffbody", false},
+		{"bom not at start", "x\ufeff---\nid: 1\n---\n", "", "", true},
+		{"missing opening", "id: 1\n---\n", "", "", true},
+		{"unclosed", "---\r\nid: 1\r\n", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm, body, err := splitFrontmatter([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if string(fm) != tt.wantFM || body != tt.wantBody {
+				t.Errorf("got fm %q body %q, want fm %q body %q", fm, body, tt.wantFM, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestParseCRLFWithBOM(t *testing.T) {
+	data := "\ufeff---\r\nid: 4\r\ntitle: Windows task\r\nstatus: todo\r\npriority: low\r\n---\r\nfirst\r\nsecond\r\n"
+	tk, err := Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tk.ID != 4 || tk.Title != "Windows task" || tk.Body != "first\nsecond" {
+		t.Errorf("parsed #%d %q body %q", tk.ID, tk.Title, tk.Body)
+	}
+}
+
+// bodyFragments are the pieces random bodies are built from: the characters
+// normalizeBody and splitFrontmatter treat specially, plus plain text.
+var bodyFragments = []string{"\n", "\r\n", "\r", " ", "\t", "---", "\n---\n", "text", "# heading", "- item", "ü", "\ufeff"}
+
+func randomBody(r *rand.Rand) string {
+	var b strings.Builder
+	for range r.IntN(12) {
+		b.WriteString(bodyFragments[r.IntN(len(bodyFragments))])
+	}
+	return b.String()
+}
+
+// TestBodyRoundTripIsStable checks, for random bodies, that one write/read
+// cycle yields the canonical body and further cycles change nothing.
+func TestBodyRoundTripIsStable(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 5000 {
+		body := randomBody(r)
+
+		first := roundTrip(t, body)
+		if first != normalizeBody(first) {
+			t.Fatalf("body %q: read back %q, which is not normalized", body, first)
+		}
+		if !strings.ContainsAny(body, "\r") && !strings.HasPrefix(body, "\n") && first != normalizeBody(body) {
+			t.Fatalf("body %q: read back %q, want %q", body, first, normalizeBody(body))
+		}
+
+		data, err := Marshal(&Task{ID: 1, Title: "t", Body: first})
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, err := Parse(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rewritten, err := Marshal(again)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again.Body != first || !bytes.Equal(rewritten, data) {
+			t.Fatalf("body %q: second cycle changed %q to %q", body, first, again.Body)
+		}
+	}
+}
+
+// roundTrip writes a task with body and returns the body read back.
+func roundTrip(t *testing.T, body string) string {
+	t.Helper()
+	data, err := Marshal(&Task{ID: 1, Title: "t", Body: body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("body %q: %v", body, err)
+	}
+	return parsed.Body
+}
+
+func TestExtraRoundTripsNestedValues(t *testing.T) {
+	const input = `---
+id: 1
+title: t
+status: todo
+priority: medium
+created: 2026-01-02T03:04:05Z
+updated: 2026-01-02T03:04:05Z
+zeta: last
+jira:
+    key: PROJ-12
+    sprint: 7
+    labels:
+        - api
+        - urgent
+    links:
+        - rel: epic
+          url: https://example.com/PROJ-1
+alpha:
+    enabled: true
+    ratio: 0.5
+    empty: null
+---
+body
+`
+	first, err := Parse([]byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"zeta": "last",
+		"jira": map[string]any{
+			"key":    "PROJ-12",
+			"sprint": 7,
+			"labels": []any{"api", "urgent"},
+			"links":  []any{map[string]any{"rel": "epic", "url": "https://example.com/PROJ-1"}},
+		},
+		"alpha": map[string]any{"enabled": true, "ratio": 0.5, "empty": nil},
+	}
+	if !reflect.DeepEqual(first.Extra, want) {
+		t.Fatalf("Extra = %#v\nwant    %#v", first.Extra, want)
+	}
+
+	data, err := Marshal(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(second.Extra, want) {
+		t.Errorf("Extra after round trip = %#v\nwant %#v", second.Extra, want)
+	}
+	again, err := Marshal(second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, data) {
+		t.Errorf("second write differs:\n%s\nwant\n%s", again, data)
+	}
+
+	// Unknown keys follow the known fields, sorted by key.
+	s := string(data)
+	if i, j, k, u := strings.Index(s, "alpha:"), strings.Index(s, "jira:"), strings.Index(s, "zeta:"), strings.Index(s, "updated:"); !(u < i && i < j && j < k) {
+		t.Errorf("key order: updated@%d alpha@%d jira@%d zeta@%d, want known fields then sorted extras\n%s", u, i, j, k, s)
+	}
+}
@@ -14,35 +14,70 @@ import (
 // idPrefixRe matches the numeric ID prefix of a task filename.
 var idPrefixRe = regexp.MustCompile(`^(\d+)-`)
 
+// digitRunRe matches every run of digits in a filename.
+var digitRunRe = regexp.MustCompile(`\d+`)
+
 // FindByID scans the tasks directory for a file matching the given ID.
 // Returns the full path to the task file.
+//
+// Filenames follow tasks.filename_format, so the ID may sit anywhere in the
+// name. Files containing the ID as a digit run are candidates; unless a
+// single candidate starts with the ID, the frontmatter decides (e.g. for a
+// date prefix or a number in the slug).
 func FindByID(tasksDir string, id int) (string, error) {
 	entries, err := os.ReadDir(tasksDir)
 	if err != nil {
 		return "", fmt.Errorf("reading tasks directory: %w", err)
 	}
 
-	idStr := strconv.Itoa(id)
+	var candidates []string
 	for _, entry := range entries {
 		name := entry.Name()
 		if entry.IsDir() || !strings.HasSuffix(name, ".md") {
 			continue
 		}
-		// Strip leading zeros and check if the numeric prefix matches the ID.
-		dash := strings.IndexByte(name, '-')
-		if dash < 1 {
-			continue
+		if containsIDRun(name, id) {
+			candidates = append(candidates, name)
+		}
+	}
+
+	// Fast path: a lone default-format name needs no frontmatter check.
+	if len(candidates) == 1 {
+		if n, err := ExtractIDFromFilename(candidates[0]); err == nil && n == id {
+			return filepath.Join(tasksDir, candidates[0]), nil
+		}
+	}
+	unreadable := ""
+	for _, name := range candidates {
+		path := filepath.Join(tasksDir, name)
+		t, err := Read(path)
+		if err == nil && t.ID == id {
+			return path, nil
 		}
-		prefix := strings.TrimLeft(name[:dash], "0")
-		if prefix == idStr {
-			return filepath.Join(tasksDir, name), nil
+		// A malformed file can only be matched by its default-format prefix.
+		if n, idErr := ExtractIDFromFilename(name); err != nil && idErr == nil && n == id && unreadable == "" {
+			unreadable = path
 		}
 	}
+	if unreadable != "" {
+		return unreadable, nil
+	}
 
 	return "", clierr.Newf(clierr.TaskNotFound, "task not found: #%d", id).
 		WithDetails(map[string]any{"id": id})
 }
 
+// containsIDRun reports whether name contains id as a whole digit run,
+// ignoring leading zeros.
+func containsIDRun(name string, id int) bool {
+	for _, run := range digitRunRe.FindAllString(name, -1) {
+		if n, err := strconv.Atoi(run); err == nil && n == id {
+			return true
+		}
+	}
+	return false
+}
+
 // ReadAll reads all task files from the given directory.
 func ReadAll(tasksDir string) ([]*Task, error) {
 	entries, err := os.ReadDir(tasksDir)
@@ -106,11 +141,15 @@ func ReadAllLenient(tasksDir string) ([]*Task, []ReadWarning, error) {
 	return tasks, warnings, nil
 }
 
-// ExtractIDFromFilename extracts the numeric ID from a task filename.
+// ExtractIDFromFilename extracts the numeric ID from a task filename: the
+// leading number in the default format, or the only number in the name.
+// Use config.FilenameFormat.ExtractID for custom formats.
 func ExtractIDFromFilename(filename string) (int, error) {
-	matches := idPrefixRe.FindStringSubmatch(filename)
-	if len(matches) < 2 { //nolint:mnd // regex capture group
-		return 0, fmt.Errorf("cannot extract ID from filename %q", filename)
+	if matches := idPrefixRe.FindStringSubmatch(filename); len(matches) == 2 { //nolint:mnd // regex capture group
+		return strconv.Atoi(matches[1])
+	}
+	if runs := digitRunRe.FindAllString(filename, -1); len(runs) == 1 {
+		return strconv.Atoi(runs[0])
 	}
-	return strconv.Atoi(matches[1])
+	return 0, fmt.Errorf("cannot extract ID from filename %q", filename)
 }
@@ -7,21 +7,27 @@ import (
 )
 
 // UpdateTimestamps sets Started and Completed based on the status transition.
-//   - Sets Started on first move out of initial status (never overwrites).
+// An empty oldStatus means the task is being created. Initial statuses are the
+// first column and the configured default status (see IsInitialStatus).
+//   - Sets Started on first move out of an initial status, or on creation
+//     past one (never overwrites).
 //   - Sets Completed on move to terminal status; also sets Started if nil.
 //   - Clears Completed when moving away from terminal status (reopening).
 func UpdateTimestamps(t *Task, oldStatus, newStatus string, cfg *config.Config) {
 	now := time.Now()
-	initialStatus := cfg.StatusNames()[0]
 
 	// Set Started on first move out of initial status (never overwrite).
-	if t.Started == nil && oldStatus == initialStatus && newStatus != initialStatus {
+	fromInitial := oldStatus == "" || cfg.IsInitialStatus(oldStatus)
+	if t.Started == nil && fromInitial && !cfg.IsInitialStatus(newStatus) {
 		t.Started = &now
 	}
 
-	// Set/clear Completed based on terminal status.
+	// Set/clear Completed based on terminal status. Moving between terminal
+	// statuses (e.g. done -> archived) keeps the original completion time.
 	if cfg.IsTerminalStatus(newStatus) {
-		t.Completed = &now
+		if t.Completed == nil || !cfg.IsTerminalStatus(oldStatus) {
+			t.Completed = &now
+		}
 		// Direct move to terminal: also set Started if nil.
 		if t.Started == nil {
 			t.Started = &now
@@ -31,3 +37,12 @@ func UpdateTimestamps(t *Task, oldStatus, newStatus string, cfg *config.Config)
 		t.Completed = nil
 	}
 }
+
+// RecordTransition appends a status change to the task's history, keeping at
+// most the limit newest entries.
+func RecordTransition(t *Task, from, to, actor string, at time.Time, limit int) {
+	t.History = append(t.History, Transition{At: at, From: from, To: to, Actor: actor})
+	if limit > 0 && len(t.History) > limit {
+		t.History = t.History[len(t.History)-limit:]
+	}
+}
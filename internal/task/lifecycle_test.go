@@ -0,0 +1,86 @@
+package task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
+)
+
+func TestUpdateTimestampsKeepsCompletedBetweenTerminalStatuses(t *testing.T) {
+	cfg := config.NewDefault("test")
+	done := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tk := &Task{Status: config.ArchivedStatus, Started: &done, Completed: &done}
+
+	UpdateTimestamps(tk, "done", config.ArchivedStatus, cfg)
+
+	if tk.Completed == nil || !tk.Completed.Equal(done) {
+		t.Errorf("Completed = %v, want %v", tk.Completed, done)
+	}
+}
+
+func TestUpdateTimestampsCompletedTransitions(t *testing.T) {
+	cfg := config.NewDefault("test")
+	earlier := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		from, to      string
+		completed     *time.Time
+		wantCompleted bool
+		wantReset     bool
+	}{
+		{"active to terminal sets completed", "in-progress", "done", nil, true, true},
+		{"terminal to archived keeps completed", "done", config.ArchivedStatus, &earlier, true, false},
+		{"archived to terminal keeps completed", config.ArchivedStatus, "done", &earlier, true, false},
+		{"terminal without completed sets it", "done", config.ArchivedStatus, nil, true, true},
+		{"reopen clears completed", "done", "todo", &earlier, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk := &Task{Status: tt.to, Completed: tt.completed}
+			UpdateTimestamps(tk, tt.from, tt.to, cfg)
+
+			if got := tk.Completed != nil; got != tt.wantCompleted {
+				t.Fatalf("Completed set = %v, want %v", got, tt.wantCompleted)
+			}
+			if tt.wantCompleted && tk.Completed.Equal(earlier) == tt.wantReset {
+				t.Errorf("Completed = %v, reset = %v", tk.Completed, tt.wantReset)
+			}
+		})
+	}
+}
+
+func TestUpdateTimestampsStartedWithDefaultPastFirstColumn(t *testing.T) {
+	cfg := config.NewDefault("test")
+	cfg.Defaults.Status = "todo"
+
+	for status, want := range map[string]bool{"backlog": true, "todo": true, "in-progress": false, "done": false} {
+		if got := cfg.IsInitialStatus(status); got != want {
+			t.Errorf("IsInitialStatus(%q) = %v, want %v", status, got, want)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		from, to    string
+		wantStarted bool
+	}{
+		{"create in default", "", "todo", false},
+		{"first column to default", "backlog", "todo", false},
+		{"default to first column", "todo", "backlog", false},
+		{"default to in progress", "todo", "in-progress", true},
+		{"first column to in progress", "backlog", "in-progress", true},
+		{"create past default", "", "review", true},
+		{"between active statuses", "in-progress", "review", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk := &Task{Status: tt.to}
+			UpdateTimestamps(tk, tt.from, tt.to, cfg)
+			if got := tk.Started != nil; got != tt.wantStarted {
+				t.Errorf("Started set = %v, want %v", got, tt.wantStarted)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package task
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+)
+
+// Link points a task at an external resource such as a pull request, commit
+// or ticket. Rel names the kind of reference (e.g. "pr", "commit").
+type Link struct {
+	Rel string `yaml:"rel,omitempty" json:"rel,omitempty"`
+	URL string `yaml:"url" json:"url"`
+}
+
+// String renders the link as "rel: url", or just the URL without a rel.
+func (l Link) String() string {
+	if l.Rel == "" {
+		return l.URL
+	}
+	return l.Rel + ": " + l.URL
+}
+
+// FormatLinks renders links as a comma-separated list.
+func FormatLinks(links []Link) string {
+	parts := make([]string, len(links))
+	for i, l := range links {
+		parts[i] = l.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseLink parses "URL" or "REL=URL". A prefix before the first "=" is
+// taken as the rel only if it contains no ":" or "/", so query strings in
+// bare URLs are kept intact. URLs are validated loosely: they must not
+// contain whitespace, and anything with a scheme needs a host or path.
+func ParseLink(input string) (Link, error) {
+	var l Link
+	raw := strings.TrimSpace(input)
+	if rel, rest, ok := strings.Cut(raw, "="); ok && rel != "" && !strings.ContainsAny(rel, ":/") {
+		l.Rel, raw = strings.TrimSpace(rel), strings.TrimSpace(rest)
+	}
+	l.URL = raw
+
+	if l.URL == "" || strings.ContainsFunc(l.URL, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' }) {
+		return Link{}, invalidLink(input)
+	}
+	if strings.Contains(l.URL, "://") {
+		u, err := url.Parse(l.URL)
+		if err != nil || u.Scheme == "" || (u.Host == "" && u.Path == "") {
+			return Link{}, invalidLink(input)
+		}
+	}
+	return l, nil
+}
+
+// ParseLinks parses each input with ParseLink.
+func ParseLinks(inputs []string) ([]Link, error) {
+	links := make([]Link, 0, len(inputs))
+	for _, s := range inputs {
+		l, err := ParseLink(s)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+	return links, nil
+}
+
+func invalidLink(input string) *clierr.Error {
+	return clierr.Newf(clierr.InvalidInput, "invalid link %q (use URL or REL=URL)", input).
+		WithDetails(map[string]any{"input": input})
+}
+
+// AddLinks appends links whose URL is not already present. A link with a new
+// rel for an existing URL updates its rel.
+func AddLinks(links []Link, add ...Link) []Link {
+	for _, l := range add {
+		i := slices.IndexFunc(links, func(x Link) bool { return x.URL == l.URL })
+		switch {
+		case i < 0:
+			links = append(links, l)
+		case l.Rel != "":
+			links[i].Rel = l.Rel
+		}
+	}
+	return links
+}
+
+// RemoveLinks drops every link whose URL or rel matches one of keys.
+func RemoveLinks(links []Link, keys ...string) []Link {
+	return slices.DeleteFunc(links, func(l Link) bool {
+		return slices.Contains(keys, l.URL) || (l.Rel != "" && slices.Contains(keys, l.Rel))
+	})
+}
+
+// HasLinkRel reports whether t has a link with the given rel.
+func HasLinkRel(t *Task, rel string) bool {
+	return slices.ContainsFunc(t.Links, func(l Link) bool { return l.Rel == rel })
+}
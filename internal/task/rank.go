@@ -0,0 +1,85 @@
+package task
+
+import "strings"
+
+// rankDigits are the digits of a rank, in order. Ranks compare as plain
+// strings, so any two can be split by a new rank without touching others.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// ValidRank reports whether s is a usable rank: non-empty, made of
+// rankDigits, and not ending in the lowest digit, so there is room below it.
+func ValidRank(s string) bool {
+	if s == "" || s[len(s)-1] == rankDigits[0] {
+		return false
+	}
+	for i := range len(s) {
+		if strings.IndexByte(rankDigits, s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// RankBetween returns a rank that sorts strictly between lo and hi. An empty
+// lo means "before everything", an empty hi "after everything". Invalid
+// bounds (see ValidRank) are treated as empty, and hi is ignored unless it
+// sorts after lo. The result is always a valid rank.
+func RankBetween(lo, hi string) string {
+	if !ValidRank(lo) {
+		lo = ""
+	}
+	if !ValidRank(hi) || lo >= hi {
+		hi = ""
+	}
+	base := len(rankDigits)
+	var b strings.Builder
+	open := hi == "" // no upper bound left to respect
+	// Valid bounds never need more digits than the longer one plus one; the
+	// cap only guards the loop.
+	limit := max(len(lo), len(hi)) + 2 //nolint:mnd // one digit past the longer bound, plus slack
+	for i := range limit {
+		l := 0
+		if i < len(lo) {
+			l = strings.IndexByte(rankDigits, lo[i])
+		}
+		h := base
+		if !open {
+			h = 0
+			if i < len(hi) {
+				h = strings.IndexByte(rankDigits, hi[i])
+			}
+		}
+		switch {
+		case h-l >= 2: //nolint:mnd // room for a digit strictly between
+			b.WriteByte(rankDigits[(l+h)/2])
+			return b.String()
+		case h-l == 1:
+			// Take lo's digit; everything after it is below hi.
+			b.WriteByte(rankDigits[l])
+			open = true
+		default:
+			b.WriteByte(rankDigits[l])
+		}
+	}
+	if hi == "" {
+		return lo + rankDigits[base/2:base/2+1]
+	}
+	return RankBetween(lo, "")
+}
+
+// CompareRank orders tasks by rank: ranked tasks first in rank order, then
+// unranked ones. Returns -1, 0 or 1.
+func CompareRank(a, b *Task) int {
+	switch {
+	case a.Rank == b.Rank:
+		return 0
+	case b.Rank == "":
+		return -1
+	case a.Rank == "":
+		return 1
+	case a.Rank < b.Rank:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 )
 
 const maxSlugLength = 50
@@ -32,7 +34,14 @@ func GenerateSlug(title string) string {
 	return slug
 }
 
-// GenerateFilename creates a task filename from an ID and slug.
+// FilenameFor returns the filename for a task under the board's
+// tasks.filename_format.
+func FilenameFor(cfg *config.Config, t *Task) string {
+	return cfg.FilenameFormat().Render(t.ID, GenerateSlug(t.Title), t.Created)
+}
+
+// GenerateFilename creates a task filename from an ID and slug in the
+// default format.
 func GenerateFilename(id int, slug string) string {
 	padWidth := 3
 	idStr := strconv.Itoa(id)
@@ -9,25 +9,38 @@ import (
 
 // Task represents a kanban task parsed from a markdown file.
 type Task struct {
-	ID          int        `yaml:"id" json:"id"`
-	Title       string     `yaml:"title" json:"title"`
-	Status      string     `yaml:"status" json:"status"`
-	Priority    string     `yaml:"priority" json:"priority"`
-	Created     time.Time  `yaml:"created" json:"created"`
-	Updated     time.Time  `yaml:"updated" json:"updated"`
-	Started     *time.Time `yaml:"started,omitempty" json:"started,omitempty"`
-	Completed   *time.Time `yaml:"completed,omitempty" json:"completed,omitempty"`
-	Assignee    string     `yaml:"assignee,omitempty" json:"assignee,omitempty"`
-	Tags        []string   `yaml:"tags,omitempty" json:"tags,omitempty"`
-	Due         *date.Date `yaml:"due,omitempty" json:"due,omitempty"`
-	Estimate    string     `yaml:"estimate,omitempty" json:"estimate,omitempty"`
-	Parent      *int       `yaml:"parent,omitempty" json:"parent,omitempty"`
-	DependsOn   []int      `yaml:"depends_on,omitempty" json:"depends_on,omitempty"`
-	Blocked     bool       `yaml:"blocked,omitempty" json:"blocked,omitempty"`
-	BlockReason string     `yaml:"block_reason,omitempty" json:"block_reason,omitempty"`
-	ClaimedBy   string     `yaml:"claimed_by,omitempty" json:"claimed_by,omitempty"`
-	ClaimedAt   *time.Time `yaml:"claimed_at,omitempty" json:"claimed_at,omitempty"`
-	Class       string     `yaml:"class,omitempty" json:"class,omitempty"`
+	ID          int            `yaml:"id" json:"id"`
+	Title       string         `yaml:"title" json:"title"`
+	Status      string         `yaml:"status" json:"status"`
+	Priority    string         `yaml:"priority" json:"priority"`
+	Rank        string         `yaml:"rank,omitempty" json:"rank,omitempty"`
+	Created     time.Time      `yaml:"created" json:"created"`
+	Updated     time.Time      `yaml:"updated" json:"updated"`
+	Started     *time.Time     `yaml:"started,omitempty" json:"started,omitempty"`
+	Completed   *time.Time     `yaml:"completed,omitempty" json:"completed,omitempty"`
+	Assignee    string         `yaml:"assignee,omitempty" json:"assignee,omitempty"`
+	Tags        []string       `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Links       []Link         `yaml:"links,omitempty" json:"links,omitempty"`
+	Due         *date.Date     `yaml:"due,omitempty" json:"due,omitempty"`
+	Estimate    string         `yaml:"estimate,omitempty" json:"estimate,omitempty"`
+	Parent      *int           `yaml:"parent,omitempty" json:"parent,omitempty"`
+	DependsOn   []int          `yaml:"depends_on,omitempty" json:"depends_on,omitempty"`
+	Blocked     bool           `yaml:"blocked,omitempty" json:"blocked,omitempty"`
+	BlockReason string         `yaml:"block_reason,omitempty" json:"block_reason,omitempty"`
+	ClaimedBy   string         `yaml:"claimed_by,omitempty" json:"claimed_by,omitempty"`
+	ClaimedAt   *time.Time     `yaml:"claimed_at,omitempty" json:"claimed_at,omitempty"`
+	Class       string         `yaml:"class,omitempty" json:"class,omitempty"`
+	TimeLog     []Interval     `yaml:"time_log,omitempty" json:"-"` // legacy; moved into Worklog by Parse
+	Worklog     []WorklogEntry `yaml:"worklog,omitempty" json:"worklog,omitempty"`
+	History     []Transition   `yaml:"history,omitempty" json:"history,omitempty"`
+
+	// Extra holds frontmatter keys agentwatch does not know, such as those
+	// added by other tools. They are written back after the known fields,
+	// sorted by key, so rewriting a task never drops them.
+	Extra map[string]any `yaml:",inline" json:"extra,omitempty"`
+
+	// TotalLoggedSeconds is the sum of closed worklog entries (not in YAML).
+	TotalLoggedSeconds int64 `yaml:"-" json:"total_logged_seconds,omitempty"`
 
 	// Body is the markdown content below the frontmatter (not in YAML).
 	Body string `yaml:"-" json:"body,omitempty"`
@@ -35,3 +48,19 @@ type Task struct {
 	// File is the path to the task file (not in YAML).
 	File string `yaml:"-" json:"file,omitempty"`
 }
+
+// Transition is one status change recorded in the task file. From is empty
+// for the status a task was created in.
+type Transition struct {
+	At    time.Time `yaml:"at" json:"at"`
+	From  string    `yaml:"from,omitempty" json:"from,omitempty"`
+	To    string    `yaml:"to" json:"to"`
+	Actor string    `yaml:"actor,omitempty" json:"actor,omitempty"`
+}
+
+// Interval is a working period from the legacy time_log field. End is nil
+// while tracking is running. Parse converts intervals to worklog entries.
+type Interval struct {
+	Start time.Time  `yaml:"start" json:"start"`
+	End   *time.Time `yaml:"end,omitempty" json:"end,omitempty"`
+}
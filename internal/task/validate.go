@@ -1,9 +1,12 @@
 package task
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/config"
 )
 
 // ValidateStatus checks that a status is in the allowed list.
@@ -20,6 +23,16 @@ func ValidateStatus(status string, allowed []string) error {
 		})
 }
 
+// ResolveStatus returns the canonical status for input, accepting any case
+// and configured aliases (see config.ResolveStatus). Unknown input yields an
+// InvalidStatus error listing the canonical names.
+func ResolveStatus(cfg *config.Config, status string) (string, error) {
+	if s, ok := cfg.ResolveStatus(status); ok {
+		return s, nil
+	}
+	return "", ValidateStatus(status, cfg.StatusNames())
+}
+
 // ValidatePriority checks that a priority is in the allowed list.
 func ValidatePriority(priority string, allowed []string) error {
 	for _, p := range allowed {
@@ -49,6 +62,13 @@ func ValidateTaskID(input string) *clierr.Error {
 		WithDetails(map[string]any{"input": input})
 }
 
+// ValidateIDExists returns a CLIError for an explicit task ID that is
+// already taken by the file at path.
+func ValidateIDExists(id int, path string) *clierr.Error {
+	return clierr.Newf(clierr.TaskIDExists, "task #%d already exists: %s", id, path).
+		WithDetails(map[string]any{"id": id, "file": path})
+}
+
 // ValidateSelfReference returns a CLIError for self-referencing dependency.
 func ValidateSelfReference(id int) *clierr.Error {
 	return clierr.Newf(clierr.SelfReference, "task cannot depend on itself (ID %d)", id).
@@ -61,6 +81,17 @@ func ValidateDependencyNotFound(depID int) *clierr.Error {
 		WithDetails(map[string]any{"id": depID})
 }
 
+// ValidateDependencyCycle returns a CLIError for a dependency or parent cycle.
+// path lists the task IDs around the cycle, starting and ending with the same ID.
+func ValidateDependencyCycle(kind string, path []int) *clierr.Error {
+	parts := make([]string, len(path))
+	for i, id := range path {
+		parts[i] = "#" + strconv.Itoa(id)
+	}
+	return clierr.Newf(clierr.DependencyCycle, "%s cycle: %s", kind, strings.Join(parts, " -> ")).
+		WithDetails(map[string]any{"kind": kind, "cycle": path})
+}
+
 // ValidateWIPLimit returns a CLIError for WIP limit violations.
 func ValidateWIPLimit(status string, limit, current int) *clierr.Error {
 	return clierr.Newf(clierr.WIPLimitExceeded,
@@ -129,6 +160,17 @@ func ValidateClassWIPExceeded(class string, limit, current int) *clierr.Error {
 		})
 }
 
+// ValidatePriorityWIPExceeded returns a CLIError for priority-level WIP limit violations.
+func ValidatePriorityWIPExceeded(priority string, limit, current int) *clierr.Error {
+	return clierr.Newf(clierr.PriorityWIPExceeded,
+		"%s priority WIP limit reached (%d/%d in flight board-wide)", priority, current, limit).
+		WithDetails(map[string]any{
+			"priority": priority,
+			"limit":    limit,
+			"current":  current,
+		})
+}
+
 // CheckClaim verifies that a mutating operation is allowed on a claimed task.
 // If the task is unclaimed, claimed by the same agent, or expired, the operation
 // proceeds. Otherwise, returns a TaskClaimed error.
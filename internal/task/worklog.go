@@ -0,0 +1,137 @@
+package task
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/twiced-technology-gmbh/agentwatch/internal/clierr"
+	"github.com/twiced-technology-gmbh/agentwatch/internal/date"
+)
+
+// WorklogEntry is one piece of logged work. Duration is empty while the entry
+// is open (between "worklog start" and "worklog stop").
+type WorklogEntry struct {
+	Start    time.Time `yaml:"start" json:"start"`
+	Duration string    `yaml:"duration,omitempty" json:"duration,omitempty"`
+	Agent    string    `yaml:"agent,omitempty" json:"agent,omitempty"`
+	Note     string    `yaml:"note,omitempty" json:"note,omitempty"`
+}
+
+// ParseWorklogDuration parses a logged duration such as "45m", "1h30m" or "1d".
+func ParseWorklogDuration(s string) (time.Duration, error) {
+	d, err := date.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, errors.New("duration must be positive")
+	}
+	return d, nil
+}
+
+// ValidateWorklogDuration returns a CLIError for invalid worklog durations.
+func ValidateWorklogDuration(input string, err error) *clierr.Error {
+	return clierr.Newf(clierr.InvalidInput,
+		"invalid duration %q: expected a positive duration like 45m, 2h, or 1d", input).
+		WithDetails(map[string]any{
+			"field": "duration",
+			"input": input,
+			"error": err.Error(),
+		})
+}
+
+// AddWorklog appends a closed entry of d ending at now.
+func AddWorklog(t *Task, d time.Duration, agent, note string, now time.Time) {
+	t.Worklog = append(t.Worklog, WorklogEntry{
+		Start:    now.Add(-d),
+		Duration: d.String(),
+		Agent:    agent,
+		Note:     note,
+	})
+	t.TotalLoggedSeconds = int64(LoggedTime(t).Seconds())
+}
+
+// StartWorklog appends an open entry starting at now. Returns a StatusConflict
+// error if the task already has an open entry.
+func StartWorklog(t *Task, agent, note string, now time.Time) error {
+	if OpenWorklog(t) != nil {
+		return clierr.Newf(clierr.StatusConflict, "task #%d already has an open worklog entry", t.ID).
+			WithDetails(map[string]any{"id": t.ID})
+	}
+	t.Worklog = append(t.Worklog, WorklogEntry{Start: now, Agent: agent, Note: note})
+	return nil
+}
+
+// StopWorklog closes the open entry at now, rounded to the second, and returns
+// its length. Returns an InvalidInput error if no entry is open.
+func StopWorklog(t *Task, now time.Time) (time.Duration, error) {
+	e := OpenWorklog(t)
+	if e == nil {
+		return 0, clierr.Newf(clierr.InvalidInput, "task #%d has no open worklog entry (use 'worklog start')", t.ID).
+			WithDetails(map[string]any{"id": t.ID})
+	}
+	d := max(now.Sub(e.Start).Round(time.Second), time.Second)
+	e.Duration = d.String()
+	t.TotalLoggedSeconds = int64(LoggedTime(t).Seconds())
+	return d, nil
+}
+
+// OpenWorklog returns the task's open worklog entry, or nil.
+func OpenWorklog(t *Task) *WorklogEntry {
+	for i := range t.Worklog {
+		if t.Worklog[i].Duration == "" {
+			return &t.Worklog[i]
+		}
+	}
+	return nil
+}
+
+// LoggedTime sums the durations of closed worklog entries. Entries with an
+// unparseable duration are skipped.
+func LoggedTime(t *Task) time.Duration {
+	var total time.Duration
+	for _, e := range t.Worklog {
+		if d, err := ParseWorklogDuration(e.Duration); e.Duration != "" && err == nil {
+			total += d
+		}
+	}
+	return total
+}
+
+// LoggedVariance compares a completed task's logged time with its estimate.
+// Returns ok=false unless the task is completed with a parseable estimate and
+// some logged time.
+func LoggedVariance(t *Task) (estimate, logged time.Duration, ok bool) {
+	if t.Estimate == "" || t.Completed == nil {
+		return 0, 0, false
+	}
+	est, err := ParseEstimate(t.Estimate)
+	if err != nil {
+		return 0, 0, false
+	}
+	logged = LoggedTime(t)
+	if logged == 0 {
+		return 0, 0, false
+	}
+	return est, logged, true
+}
+
+// migrateTimeLog moves legacy time_log intervals into the worklog, so tracked
+// and logged time share one source. The next write drops time_log.
+func migrateTimeLog(t *Task) {
+	if len(t.TimeLog) == 0 {
+		return
+	}
+	for _, iv := range t.TimeLog {
+		e := WorklogEntry{Start: iv.Start}
+		if iv.End != nil {
+			e.Duration = max(iv.End.Sub(iv.Start).Round(time.Second), time.Second).String()
+		}
+		t.Worklog = append(t.Worklog, e)
+	}
+	t.TimeLog = nil
+	sort.SliceStable(t.Worklog, func(i, j int) bool {
+		return t.Worklog[i].Start.Before(t.Worklog[j].Start)
+	})
+}
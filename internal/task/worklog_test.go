@@ -0,0 +1,79 @@
+package task
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const legacyTimeLogTask = `---
+id: 3
+title: tracked
+status: done
+priority: medium
+created: 2026-04-01T09:00:00Z
+updated: 2026-04-01T12:00:00Z
+completed: 2026-04-01T12:00:00Z
+estimate: 2h
+time_log:
+    - start: 2026-04-01T10:00:00Z
+      end: 2026-04-01T11:00:00Z
+    - start: 2026-04-01T11:30:00Z
+worklog:
+    - start: 2026-04-01T09:00:00Z
+      duration: 30m0s
+      agent: alice
+---
+`
+
+func TestParseMigratesTimeLogIntoWorklog(t *testing.T) {
+	tk, err := Parse([]byte(legacyTimeLogTask))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tk.TimeLog != nil {
+		t.Errorf("TimeLog = %v, want nil after migration", tk.TimeLog)
+	}
+	if len(tk.Worklog) != 3 {
+		t.Fatalf("Worklog has %d entries, want 3: %+v", len(tk.Worklog), tk.Worklog)
+	}
+	if tk.Worklog[0].Agent != "alice" || tk.Worklog[1].Duration != "1h0m0s" {
+		t.Errorf("Worklog not merged in start order: %+v", tk.Worklog)
+	}
+	if open := OpenWorklog(tk); open == nil || !open.Start.Equal(time.Date(2026, 4, 1, 11, 30, 0, 0, time.UTC)) {
+		t.Errorf("open entry = %+v, want the running interval", open)
+	}
+	if got, want := LoggedTime(tk), 90*time.Minute; got != want {
+		t.Errorf("LoggedTime = %v, want %v", got, want)
+	}
+	if _, logged, ok := LoggedVariance(tk); !ok || logged != 90*time.Minute {
+		t.Errorf("LoggedVariance logged = %v ok = %v, want tracked time included", logged, ok)
+	}
+
+	data, err := Marshal(tk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "time_log") {
+		t.Errorf("rewritten task still has time_log:\n%s", data)
+	}
+}
+
+func TestStopWorklogAfterStart(t *testing.T) {
+	start := time.Date(2026, 4, 1, 10, 0, 0, 0, time.UTC)
+	tk := &Task{ID: 1}
+	if err := StartWorklog(tk, "bob", "", start); err != nil {
+		t.Fatal(err)
+	}
+	if err := StartWorklog(tk, "bob", "", start); err == nil {
+		t.Error("second StartWorklog succeeded, want conflict")
+	}
+	d, err := StopWorklog(tk, start.Add(45*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d != 45*time.Minute || tk.TotalLoggedSeconds != 45*60 {
+		t.Errorf("stopped after %v with total %ds, want 45m", d, tk.TotalLoggedSeconds)
+	}
+}
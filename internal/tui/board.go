@@ -7,7 +7,6 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
@@ -31,11 +30,12 @@ const (
 
 // Key and layout constants.
 const (
-	keyEsc = "esc"
+	keyEsc        = "esc"
+	defaultEditor = "vi"
 
-	tagMaxFraction = 2 // tags get at most 1/N of card width
-	boardChrome    = 2 // blank line + status bar below the column area
-	errorChrome    = 1 // extra line when error toast is displayed
+	tagMaxFraction = 2                // tags get at most 1/N of card width
+	boardChrome    = 2                // blank line + status bar below the column area
+	errorChrome    = 1                // extra line when error toast is displayed
 	tickInterval   = 30 * time.Second // how often durations refresh
 )
 
@@ -111,6 +111,13 @@ func (b *Board) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case errMsg:
 		b.err = msg.err
 		return b, nil
+	case editorFinishedMsg:
+		b.err = nil
+		if msg.err != nil {
+			b.err = fmt.Errorf("editor: %w", msg.err)
+		}
+		b.loadTasks()
+		return b, nil
 	}
 	return b, nil
 }
@@ -180,10 +187,154 @@ func (b *Board) handleBoardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		b.handleDeleteStart()
 	case "enter":
 		b.focusITermPane()
+	case "e":
+		return b, b.editSelectedTask()
+	case "+", "=":
+		b.shiftPriority(1)
+	case "-":
+		b.shiftPriority(-1)
+	case "J", "shift+down":
+		b.shiftRank(1)
+	case "K", "shift+up":
+		b.shiftRank(-1)
 	}
 	return b, nil
 }
 
+// shiftPriority moves the selected task delta steps along cfg.Priorities
+// (positive raises it), keeping it selected. At either end it does nothing.
+func (b *Board) shiftPriority(delta int) {
+	sel := b.selectedTask()
+	if sel == nil {
+		return
+	}
+
+	err := board.WithLock(b.cfg.Dir(), func() error {
+		t, err := task.Read(sel.File)
+		if err != nil {
+			return fmt.Errorf("reading task #%d: %w", sel.ID, err)
+		}
+		idx := b.cfg.PriorityIndex(t.Priority)
+		next := idx + delta
+		if idx < 0 || next < 0 || next >= len(b.cfg.Priorities) {
+			return nil
+		}
+		priority := b.cfg.Priorities[next].Name
+		if err := task.ValidatePriority(priority, b.cfg.PriorityNames()); err != nil {
+			return err
+		}
+
+		t.Priority = priority
+		if err := board.EnforcePriorityWIP(b.cfg, t, t.Status); err != nil {
+			return err
+		}
+		t.Updated = b.now()
+		if err := task.Write(sel.File, t); err != nil {
+			return fmt.Errorf("writing task #%d: %w", t.ID, err)
+		}
+		board.LogMutation(b.cfg.Dir(), "edit", t.ID, t.Title)
+		return nil
+	})
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.loadTasks()
+	b.selectTask(sel.ID)
+}
+
+// shiftRank moves the selected task delta places within its column (positive
+// moves it down) by ranking it just past its neighbour, keeping it selected.
+func (b *Board) shiftRank(delta int) {
+	sel := b.selectedTask()
+	col := b.currentColumn()
+	next := b.activeRow + delta
+	if sel == nil || next < 0 || next >= len(col.tasks) {
+		return
+	}
+	neighbor := col.tasks[next]
+	where := board.PlaceAfter
+	if delta < 0 {
+		where = board.PlaceBefore
+	}
+
+	err := board.WithLock(b.cfg.Dir(), func() error {
+		tasks, _, err := task.ReadAllLenient(b.cfg.TasksPath())
+		if err != nil {
+			return err
+		}
+		column := board.Filter(tasks, board.FilterOptions{Statuses: []string{sel.Status}})
+		var t, anchor *task.Task
+		for _, c := range column {
+			switch c.ID {
+			case sel.ID:
+				t = c
+			case neighbor.ID:
+				anchor = c
+			}
+		}
+		if t == nil || anchor == nil {
+			return nil // changed on disk; the reload below shows the new state
+		}
+
+		t.Updated = b.now()
+		for _, c := range board.Rerank(column, t, where, anchor) {
+			if err := task.Write(c.File, c); err != nil {
+				return fmt.Errorf("writing task #%d: %w", c.ID, err)
+			}
+		}
+		board.LogMutation(b.cfg.Dir(), "rank", t.ID, t.Title)
+		return nil
+	})
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.loadTasks()
+	b.selectTask(sel.ID)
+}
+
+// selectTask moves the cursor to the task with the given ID in the active
+// column, if present.
+func (b *Board) selectTask(id int) {
+	col := b.currentColumn()
+	if col == nil {
+		return
+	}
+	for i, t := range col.tasks {
+		if t.ID == id {
+			b.activeRow = i
+			b.ensureVisible()
+			return
+		}
+	}
+}
+
+// editSelectedTask suspends the TUI and opens the selected task's file in
+// $EDITOR (vi if unset). Tasks are reloaded when the editor exits.
+func (b *Board) editSelectedTask() tea.Cmd {
+	t := b.selectedTask()
+	if t == nil || t.File == "" {
+		return nil
+	}
+
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+	if _, err := exec.LookPath(editor[0]); err != nil {
+		b.err = fmt.Errorf("editor %q not found (set $EDITOR)", editor[0])
+		return nil
+	}
+
+	c := exec.Command(editor[0], append(editor[1:], t.File)...) //nolint:gosec // user-chosen editor
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return editorFinishedMsg{err: err}
+	})
+}
+
 func (b *Board) handleDeleteStart() {
 	if t := b.selectedTask(); t != nil {
 		b.deleteID = t.ID
@@ -210,21 +361,26 @@ func (b *Board) handleClearAllKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (b *Board) executeClearAll() (tea.Model, tea.Cmd) {
-	tasks, _, err := task.ReadAllLenient(b.cfg.TasksPath())
-	if err != nil {
-		b.err = fmt.Errorf("reading tasks: %w", err)
-		b.view = viewBoard
-		return b, nil
-	}
-	for _, t := range tasks {
-		if b.cfg.IsArchivedStatus(t.Status) {
-			continue
+	err := board.WithLock(b.cfg.Dir(), func() error {
+		tasks, _, err := task.ReadAllLenient(b.cfg.TasksPath())
+		if err != nil {
+			return fmt.Errorf("reading tasks: %w", err)
 		}
-		t.Status = config.ArchivedStatus
-		t.Updated = b.now()
-		_ = task.Write(t.File, t)
+		for _, t := range tasks {
+			if b.cfg.IsArchivedStatus(t.Status) {
+				continue
+			}
+			task.RecordTransition(t, t.Status, config.ArchivedStatus, board.DefaultActor(), b.now(), b.cfg.MaxHistory())
+			t.Status = config.ArchivedStatus
+			t.Updated = b.now()
+			_ = task.Write(t.File, t)
+		}
+		board.LogMutation(b.cfg.Dir(), "clear-all", 0, "")
+		return nil
+	})
+	if err != nil {
+		b.err = err
 	}
-	board.LogMutation(b.cfg.Dir(), "clear-all", 0, "")
 	b.view = viewBoard
 	b.loadTasks()
 	return b, nil
@@ -360,8 +516,15 @@ func (b *Board) loadTasks() {
 	}
 	b.tasks = visibleTasks
 
-	// Sort tasks by priority (higher priority first).
-	board.Sort(visibleTasks, "priority", true, b.cfg)
+	// Sort by defaults.list.sort when configured, otherwise by priority
+	// (higher priority first).
+	spec, reverse := "priority", true
+	if d := b.cfg.Defaults.List; d.Sort != "" {
+		spec, reverse = d.Sort, d.Reverse
+	}
+	if err := board.Sort(visibleTasks, spec, reverse, b.cfg); err != nil {
+		b.err = err
+	}
 
 	// Build columns from board statuses (excludes archived).
 	displayStatuses := b.cfg.BoardStatuses()
@@ -431,12 +594,16 @@ func (b *Board) clampRow() {
 }
 
 // chromeHeight returns the number of lines consumed by non-card elements below
-// the column area: blank line + status bar (+ error line when an error is shown).
+// the column area: blank line + status bar (+ error and replenish warning
+// lines when shown).
 func (b *Board) chromeHeight() int {
 	h := boardChrome
 	if b.err != nil {
 		h += errorChrome
 	}
+	if b.replenishWarning() != "" {
+		h++
+	}
 	return h
 }
 
@@ -526,31 +693,33 @@ func (b *Board) fitCardsInHeight(col *column, avail, width int) int {
 }
 
 func (b *Board) executeDelete() (tea.Model, tea.Cmd) {
-	path, err := task.FindByID(b.cfg.TasksPath(), b.deleteID)
-	if err != nil {
-		b.err = fmt.Errorf("finding task #%d: %w", b.deleteID, err)
-		b.view = viewBoard
-		return b, nil
-	}
+	err := board.WithLock(b.cfg.Dir(), func() error {
+		path, err := task.FindByID(b.cfg.TasksPath(), b.deleteID)
+		if err != nil {
+			return fmt.Errorf("finding task #%d: %w", b.deleteID, err)
+		}
 
-	t, err := task.Read(path)
-	if err != nil {
-		b.err = fmt.Errorf("reading task #%d: %w", b.deleteID, err)
-		b.view = viewBoard
-		return b, nil
-	}
+		t, err := task.Read(path)
+		if err != nil {
+			return fmt.Errorf("reading task #%d: %w", b.deleteID, err)
+		}
 
-	if t.Status != config.ArchivedStatus {
-		oldStatus := t.Status
-		t.Status = config.ArchivedStatus
-		task.UpdateTimestamps(t, oldStatus, t.Status, b.cfg)
-		t.Updated = b.now()
-	}
+		if t.Status != config.ArchivedStatus {
+			oldStatus := t.Status
+			t.Status = config.ArchivedStatus
+			task.UpdateTimestamps(t, oldStatus, t.Status, b.cfg)
+			task.RecordTransition(t, oldStatus, t.Status, board.DefaultActor(), b.now(), b.cfg.MaxHistory())
+			t.Updated = b.now()
+		}
 
-	if err := task.Write(path, t); err != nil {
-		b.err = fmt.Errorf("archiving task #%d: %w", b.deleteID, err)
-	} else {
+		if err := task.Write(path, t); err != nil {
+			return fmt.Errorf("archiving task #%d: %w", b.deleteID, err)
+		}
 		board.LogMutation(b.cfg.Dir(), "delete", b.deleteID, b.deleteTitle)
+		return nil
+	})
+	if err != nil {
+		b.err = err
 	}
 
 	b.view = viewBoard
@@ -574,6 +743,9 @@ type ReloadMsg struct{}
 
 type errMsg struct{ err error }
 
+// editorFinishedMsg is sent when the $EDITOR process started with "e" exits.
+type editorFinishedMsg struct{ err error }
+
 // TickMsg is sent periodically to refresh duration displays.
 type TickMsg struct{}
 
@@ -614,6 +786,12 @@ var (
 				Padding(0, 1).
 				MarginBottom(0)
 
+	// atRiskCardStyle marks tasks inside their class escalation window.
+	atRiskCardStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("208")).
+			Padding(0, 1)
+
 	statusBarStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("241"))
 
@@ -784,6 +962,9 @@ func (b *Board) renderCard(t *task.Task, active bool, width int) string {
 			BorderForeground(borderColor).
 			Padding(0, 1)
 	}
+	if board.IsAtRisk(b.cfg, t, b.now()) {
+		style = atRiskCardStyle
+	}
 	if active {
 		style = activeCardStyle
 	}
@@ -812,6 +993,10 @@ func (b *Board) cardContentLines(t *task.Task, width int) []string {
 		assigneeSuffix = "  " + dimStyle.Render(t.Assignee)
 		assigneeLen = len(t.Assignee) + 2
 	}
+	if badge := b.priorityBadge(t); badge != "" {
+		assigneeSuffix = " " + badge + assigneeSuffix
+		assigneeLen += lipgloss.Width(badge) + 1
+	}
 
 	titleStyle := dimStyle
 	if len(t.Tags) > 0 {
@@ -855,6 +1040,17 @@ func (b *Board) cardContentLines(t *task.Task, width int) []string {
 		contentLines = append(contentLines, toolStyle.Render(t.ClaimedBy))
 	}
 
+	// Link line — the first link as a terminal hyperlink, with a count when
+	// there are more.
+	if len(t.Links) > 0 {
+		more := ""
+		if len(t.Links) > 1 {
+			more = fmt.Sprintf(" (+%d)", len(t.Links)-1)
+		}
+		text := truncate(t.Links[0].String(), cardWidth-2-len(more))
+		contentLines = append(contentLines, dimStyle.Render("↗ ")+hyperlink(t.Links[0].URL, dimStyle.Render(text))+dimStyle.Render(more))
+	}
+
 	// Body lines — user's task/prompt, up to 3 lines, shown in dim.
 	if t.Body != "" {
 		body := strings.TrimSpace(unescapeBody(t.Body))
@@ -867,6 +1063,19 @@ func (b *Board) cardContentLines(t *task.Task, width int) []string {
 	return contentLines
 }
 
+// priorityBadge returns a dot in the priority's configured color for tasks
+// whose priority differs from the board default, or "" otherwise.
+func (b *Board) priorityBadge(t *task.Task) string {
+	if t.Priority == b.cfg.Defaults.Priority {
+		return ""
+	}
+	color := b.cfg.PriorityColor(t.Priority)
+	if color == "" {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render("●")
+}
+
 // wrapTitle2 splits a title across maxLines lines with different widths:
 // firstWidth for the first line (shares space with the ID prefix),
 // restWidth for continuation lines (uses full card width).
@@ -963,16 +1172,50 @@ func wrapTitle(title string, maxWidth, maxLines int) []string {
 
 func (b *Board) renderStatusBar() string {
 	total := len(b.tasks)
-	status := fmt.Sprintf(" %s | %d tasks | d:del C:clear-all q:quit",
-		b.cfg.Board.Name, total)
+	risk := ""
+	if n := b.atRiskCount(); n > 0 {
+		risk = fmt.Sprintf(" | %d at risk", n)
+	}
+	status := fmt.Sprintf(" %s | %d tasks%s | e:edit +/-:prio J/K:reorder d:del C:clear-all q:quit",
+		b.cfg.Board.Name, total, risk)
 	status = truncate(status, b.width)
+	if warn := b.replenishWarning(); warn != "" {
+		status = errorStyle.Render(truncate(warn, b.width)) + "\n" + statusBarStyle.Render(status)
+	} else {
+		status = statusBarStyle.Render(status)
+	}
 
 	if b.err != nil {
 		errStr := errorStyle.Render(truncate("Error: "+b.err.Error(), b.width))
-		return errStr + "\n" + statusBarStyle.Render(status)
+		return errStr + "\n" + status
 	}
 
-	return statusBarStyle.Render(status)
+	return status
+}
+
+// atRiskCount counts tasks inside their class escalation window.
+func (b *Board) atRiskCount() int {
+	n := 0
+	now := b.now()
+	for _, t := range b.tasks {
+		if board.IsAtRisk(b.cfg, t, now) {
+			n++
+		}
+	}
+	return n
+}
+
+// replenishWarning lists statuses below their wip_minimums, or "".
+func (b *Board) replenishWarning() string {
+	under := board.Summary(b.cfg, b.tasks, board.SummaryOptions{Now: b.now()}).UnderMin()
+	if len(under) == 0 {
+		return ""
+	}
+	parts := make([]string, len(under))
+	for i, ss := range under {
+		parts[i] = fmt.Sprintf("%s %d/%d", ss.Status, ss.Count, ss.WIPMinimum)
+	}
+	return " Replenish: " + strings.Join(parts, ", ")
 }
 
 func (b *Board) viewDeleteConfirm() string {
@@ -991,6 +1234,12 @@ func (b *Board) viewClearAllConfirm() string {
 	return dialogStyle.Render(content)
 }
 
+// hyperlink wraps text in an OSC 8 escape so terminals that support it make
+// it clickable. Others ignore the escape and show text as is.
+func hyperlink(url, text string) string {
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
 // unescapeBody replaces literal escape sequences in body text with their
 // corresponding whitespace characters. This handles bodies set via CLI flags
 // where \n and \t are passed as literal two-character sequences.
@@ -1023,31 +1272,3 @@ func truncate(s string, maxLen int) string {
 	}
 	return string(runes[:target]) + "..."
 }
-
-// humanDuration formats a duration as a compact human-readable string.
-// Examples: "<1m", "5m", "2h", "3d", "2w", "3mo", "1y".
-func humanDuration(d time.Duration) string {
-	const (
-		day   = 24 * time.Hour
-		week  = 7 * day
-		month = 30 * day
-		year  = 365 * day
-	)
-
-	switch {
-	case d < time.Minute:
-		return "<1m"
-	case d < time.Hour:
-		return strconv.Itoa(int(d.Minutes())) + "m"
-	case d < day:
-		return strconv.Itoa(int(d.Hours())) + "h"
-	case d < week:
-		return strconv.Itoa(int(d/day)) + "d"
-	case d < month:
-		return strconv.Itoa(int(d/week)) + "w"
-	case d < year:
-		return strconv.Itoa(int(d/month)) + "mo"
-	default:
-		return strconv.Itoa(int(d/year)) + "y"
-	}
-}
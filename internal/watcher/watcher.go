@@ -3,6 +3,13 @@ package watcher
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,47 +21,105 @@ import (
 // single notification.
 const debounceDelay = 100 * time.Millisecond
 
+// defaultPollInterval is how often polling mode stats the watched paths.
+const defaultPollInterval = time.Second
+
+// Environment variables selecting the watch mode. AGENTWATCH_WATCH_MODE=poll
+// forces polling; AGENTWATCH_WATCH_INTERVAL sets the poll interval as a
+// duration (e.g. "2s").
+const (
+	envWatchMode     = "AGENTWATCH_WATCH_MODE"
+	envWatchInterval = "AGENTWATCH_WATCH_INTERVAL"
+)
+
+// warnOutput receives the polling fallback warning.
+var warnOutput io.Writer = os.Stderr
+
 // Watcher watches kanban board directories for changes and invokes a callback
-// with debouncing.
+// with debouncing. It uses fsnotify when available and falls back to polling
+// file modification times on filesystems where fsnotify does not work.
 type Watcher struct {
-	fsw      *fsnotify.Watcher
+	fsw      *fsnotify.Watcher // nil in polling mode
+	paths    []string
+	interval time.Duration
 	mu       sync.Mutex
 	timer    *time.Timer
 	callback func()
 }
 
-// New creates a Watcher that monitors the given paths for changes.
-// The callback is invoked (debounced) whenever a file change is detected.
+// New creates a Watcher that monitors the given paths, including their
+// non-hidden subdirectories, for changes. The callback is invoked (debounced)
+// whenever a file change is detected.
+// Polling is used when AGENTWATCH_WATCH_MODE=poll or when fsnotify cannot
+// watch a path; the latter prints a one-line warning to stderr.
 func New(paths []string, callback func()) (*Watcher, error) {
+	w := &Watcher{paths: paths, callback: callback, interval: pollInterval()}
+	if os.Getenv(envWatchMode) == "poll" {
+		return w, nil
+	}
+
+	// Any fsnotify failure falls back to polling rather than erroring.
 	fsw, err := fsnotify.NewWatcher()
 	if err != nil {
-		return nil, err
+		w.warnPolling(err)
+		return w, nil
 	}
-
 	for _, p := range paths {
-		if err := fsw.Add(p); err != nil {
+		if err := addTree(fsw, p); err != nil {
 			_ = fsw.Close()
-			return nil, err
+			w.warnPolling(err)
+			return w, nil
 		}
 	}
+	w.fsw = fsw
+	return w, nil
+}
 
-	return &Watcher{
-		fsw:      fsw,
-		callback: callback,
-	}, nil
+// warnPolling reports on stderr that fsnotify failed with err and the
+// watcher polls instead.
+func (w *Watcher) warnPolling(err error) {
+	fmt.Fprintf(warnOutput, "Warning: file watching unavailable (%v); polling every %s\n", err, w.interval)
+}
+
+// addTree watches root and, since fsnotify is not recursive, every
+// subdirectory below it. Hidden subdirectories (e.g. .sessions) are skipped.
+func addTree(fsw *fsnotify.Watcher, root string) error {
+	return walkDirs(root, fsw.Add)
+}
+
+// walkDirs calls fn for root and each non-hidden directory beneath it. A
+// root that is a file is passed to fn as is.
+func walkDirs(root string, fn func(string) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != root && (!d.IsDir() || strings.HasPrefix(d.Name(), ".")) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return fn(path)
+	})
+}
+
+// Polling reports whether the watcher polls instead of using fsnotify.
+func (w *Watcher) Polling() bool {
+	return w.fsw == nil
 }
 
 // Run starts the watch loop. It blocks until the context is canceled.
 // Errors from the underlying watcher are passed to the optional errFn callback.
 func (w *Watcher) Run(ctx context.Context, errFn func(error)) {
+	defer w.stopTimer()
+	if w.fsw == nil {
+		w.poll(ctx)
+		return
+	}
 	for {
 		select {
 		case <-ctx.Done():
-			w.mu.Lock()
-			if w.timer != nil {
-				w.timer.Stop()
-			}
-			w.mu.Unlock()
 			return
 		case event, ok := <-w.fsw.Events:
 			if !ok {
@@ -64,6 +129,9 @@ func (w *Watcher) Run(ctx context.Context, errFn func(error)) {
 			if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Remove|fsnotify.Rename) == 0 {
 				continue
 			}
+			if event.Op&fsnotify.Create != 0 {
+				w.watchNewDir(event.Name, errFn)
+			}
 			w.debounce()
 		case err, ok := <-w.fsw.Errors:
 			if !ok {
@@ -76,8 +144,23 @@ func (w *Watcher) Run(ctx context.Context, errFn func(error)) {
 	}
 }
 
+// watchNewDir starts watching a directory created after New, along with
+// anything already created inside it.
+func (w *Watcher) watchNewDir(path string, errFn func(error)) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() || strings.HasPrefix(filepath.Base(path), ".") {
+		return
+	}
+	if err := addTree(w.fsw, path); err != nil && errFn != nil {
+		errFn(err)
+	}
+}
+
 // Close stops the underlying filesystem watcher.
 func (w *Watcher) Close() error {
+	if w.fsw == nil {
+		return nil
+	}
 	return w.fsw.Close()
 }
 
@@ -90,3 +173,77 @@ func (w *Watcher) debounce() {
 	}
 	w.timer = time.AfterFunc(debounceDelay, w.callback)
 }
+
+func (w *Watcher) stopTimer() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
+
+// poll compares a snapshot of the watched paths every interval and fires the
+// debounced callback when anything changed.
+func (w *Watcher) poll(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	prev := snapshot(w.paths)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cur := snapshot(w.paths)
+			if !maps.Equal(prev, cur) {
+				w.debounce()
+			}
+			prev = cur
+		}
+	}
+}
+
+// fileState is the part of a file's metadata that polling compares.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// snapshot records the state of each path and of the entries of every
+// directory fsnotify mode would watch. Missing paths are simply absent, so
+// their creation or removal counts as a change.
+func snapshot(paths []string) map[string]fileState {
+	states := make(map[string]fileState)
+	record := func(dir string) error {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil //nolint:nilerr // unreadable directories are skipped
+		}
+		for _, e := range entries {
+			if info, err := e.Info(); err == nil {
+				states[filepath.Join(dir, e.Name())] = fileState{modTime: info.ModTime(), size: info.Size()}
+			}
+		}
+		return nil
+	}
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			continue
+		}
+		states[p] = fileState{modTime: info.ModTime(), size: info.Size()}
+		if info.IsDir() {
+			_ = walkDirs(p, record)
+		}
+	}
+	return states
+}
+
+// pollInterval returns the poll interval from AGENTWATCH_WATCH_INTERVAL, or
+// the default when unset or invalid.
+func pollInterval() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(envWatchInterval)); err == nil && d > 0 {
+		return d
+	}
+	return defaultPollInterval
+}
@@ -0,0 +1,138 @@
+package watcher
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// captureWarnings redirects warnOutput for the duration of the test.
+func captureWarnings(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prev := warnOutput
+	warnOutput = &buf
+	t.Cleanup(func() { warnOutput = prev })
+	return &buf
+}
+
+func TestNewWarnsWhenFallingBackToPolling(t *testing.T) {
+	warnings := captureWarnings(t)
+	missing := filepath.Join(t.TempDir(), "missing")
+
+	w, err := New([]string{missing}, func() {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	if !w.Polling() {
+		t.Fatal("watcher uses fsnotify for a missing path, want polling")
+	}
+	if got := warnings.String(); strings.Count(got, "\n") != 1 || !strings.Contains(got, "polling every") {
+		t.Errorf("warning = %q, want one line about polling", got)
+	}
+}
+
+func TestNewForcedPollingIsSilent(t *testing.T) {
+	warnings := captureWarnings(t)
+	t.Setenv(envWatchMode, "poll")
+
+	w, err := New([]string{t.TempDir()}, func() {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	if !w.Polling() || warnings.Len() != 0 {
+		t.Errorf("polling = %v, warning = %q; want polling without a warning", w.Polling(), warnings)
+	}
+}
+
+// mkdirs creates each directory under root.
+func mkdirs(t *testing.T, root string, dirs ...string) {
+	t.Helper()
+	for _, d := range dirs {
+		if err := os.MkdirAll(filepath.Join(root, d), 0o750); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewWatchesSubdirectories(t *testing.T) {
+	root := t.TempDir()
+	mkdirs(t, root, "a/b", ".hidden/c")
+
+	w, err := New([]string{root}, func() {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if w.Polling() {
+		t.Skip("fsnotify unavailable")
+	}
+
+	watched := w.fsw.WatchList()
+	for _, want := range []string{root, filepath.Join(root, "a"), filepath.Join(root, "a", "b")} {
+		if !slices.Contains(watched, want) {
+			t.Errorf("watch list %q is missing %q", watched, want)
+		}
+	}
+	for _, hidden := range []string{filepath.Join(root, ".hidden"), filepath.Join(root, ".hidden", "c")} {
+		if slices.Contains(watched, hidden) {
+			t.Errorf("watch list %q contains hidden %q", watched, hidden)
+		}
+	}
+}
+
+func TestRunWatchesDirectoriesCreatedAfterStart(t *testing.T) {
+	root := t.TempDir()
+	fired := make(chan struct{}, 1)
+	w, err := New([]string{root}, func() {
+		select {
+		case fired <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if w.Polling() {
+		t.Skip("fsnotify unavailable")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Run(ctx, func(err error) { t.Error(err) })
+
+	mkdirs(t, root, "new/nested")
+	nested := filepath.Join(root, "new", "nested")
+	deadline := time.Now().Add(2 * time.Second)
+	for !slices.Contains(w.fsw.WatchList(), nested) {
+		if time.Now().After(deadline) {
+			t.Fatalf("watch list %q never included %q", w.fsw.WatchList(), nested)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Drain the notification for the mkdir, then expect one for the write.
+	select {
+	case <-fired:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no callback after creating a directory")
+	}
+	if err := os.WriteFile(filepath.Join(nested, "1-task.md"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-fired:
+	case <-time.After(2 * time.Second):
+		t.Fatal("no callback after writing into a new subdirectory")
+	}
+}